@@ -0,0 +1,89 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ChecklistItem is an item of a checklist. ClickUp gives items moved
+// between others a fractional OrderIndex.
+type ChecklistItem struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	OrderIndex float64 `json:"orderindex"`
+	Assignee   *User   `json:"assignee"`
+	Resolved   bool    `json:"resolved"`
+	Parent     string  `json:"parent"`
+}
+
+type Checklist struct {
+	ID         string          `json:"id"`
+	TaskID     string          `json:"task_id"`
+	Name       string          `json:"name"`
+	OrderIndex float64         `json:"orderindex"`
+	Resolved   int             `json:"resolved"`
+	Unresolved int             `json:"unresolved"`
+	Items      []ChecklistItem `json:"items"`
+}
+
+// ChecklistRequest is the body for creating or renaming a checklist.
+type ChecklistRequest struct {
+	Name string `json:"name"`
+}
+
+// ChecklistItemRequest is the body for adding an item to a checklist.
+type ChecklistItemRequest struct {
+	Name     string `json:"name"`
+	Assignee string `json:"assignee,omitempty"`
+}
+
+// UpdateChecklistItemRequest is the body for updating a checklist item.
+// Nil fields are left unchanged.
+type UpdateChecklistItemRequest struct {
+	Name     *string `json:"name,omitempty"`
+	Assignee *string `json:"assignee,omitempty"`
+	Resolved *bool   `json:"resolved,omitempty"`
+}
+
+// checklistResponse wraps the checklist returned by checklist endpoints,
+// which includes all of its items.
+type checklistResponse struct {
+	Checklist Checklist `json:"checklist"`
+}
+
+func CreateChecklist(c *Client, taskID string, req ChecklistRequest) (Checklist, error) {
+	path := fmt.Sprintf("/task/%s/checklist", taskID)
+	resp, err := Do[ChecklistRequest, checklistResponse](c, http.MethodPost, path, &req)
+	return resp.Checklist, err
+}
+
+func UpdateChecklist(c *Client, checklistID string, req ChecklistRequest) error {
+	path := fmt.Sprintf("/checklist/%s", checklistID)
+	_, err := Do[ChecklistRequest, any](c, http.MethodPut, path, &req)
+	return err
+}
+
+func DeleteChecklist(c *Client, checklistID string) error {
+	path := fmt.Sprintf("/checklist/%s", checklistID)
+	_, err := Do[any, any](c, http.MethodDelete, path, nil)
+	return err
+}
+
+// CreateChecklistItem adds an item and returns the updated checklist.
+func CreateChecklistItem(c *Client, checklistID string, req ChecklistItemRequest) (Checklist, error) {
+	path := fmt.Sprintf("/checklist/%s/checklist_item", checklistID)
+	resp, err := Do[ChecklistItemRequest, checklistResponse](c, http.MethodPost, path, &req)
+	return resp.Checklist, err
+}
+
+func UpdateChecklistItem(c *Client, checklistID, itemID string, req UpdateChecklistItemRequest) error {
+	path := fmt.Sprintf("/checklist/%s/checklist_item/%s", checklistID, itemID)
+	_, err := Do[UpdateChecklistItemRequest, any](c, http.MethodPut, path, &req)
+	return err
+}
+
+func DeleteChecklistItem(c *Client, checklistID, itemID string) error {
+	path := fmt.Sprintf("/checklist/%s/checklist_item/%s", checklistID, itemID)
+	_, err := Do[any, any](c, http.MethodDelete, path, nil)
+	return err
+}
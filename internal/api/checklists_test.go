@@ -0,0 +1,108 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateChecklist(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/task/abc123/checklist" {
+			t.Errorf("expected path /task/abc123/checklist, got %s", r.URL.Path)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["name"] != "Definition of done" {
+			t.Errorf("expected name 'Definition of done', got %v", body["name"])
+		}
+		w.Write([]byte(`{"checklist": {"id": "cl1", "task_id": "abc123", "name": "Definition of done", "orderindex": 1.5, "items": []}}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	checklist, err := CreateChecklist(client, "abc123", ChecklistRequest{Name: "Definition of done"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if checklist.ID != "cl1" || checklist.OrderIndex != 1.5 {
+		t.Errorf("expected cl1 with a fractional order index, got %+v", checklist)
+	}
+}
+
+func TestCreateChecklistItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/checklist/cl1/checklist_item" {
+			t.Errorf("expected path /checklist/cl1/checklist_item, got %s", r.URL.Path)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["name"] != "Tests pass" || body["assignee"] != "42" {
+			t.Errorf("expected name and assignee, got %v", body)
+		}
+		w.Write([]byte(`{"checklist": {"id": "cl1", "items": [{"id": "i1", "name": "Tests pass"}]}}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	checklist, err := CreateChecklistItem(client, "cl1", ChecklistItemRequest{Name: "Tests pass", Assignee: "42"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(checklist.Items) != 1 || checklist.Items[0].ID != "i1" {
+		t.Errorf("expected the new item, got %+v", checklist.Items)
+	}
+}
+
+func TestUpdateChecklistItemSendsOnlySetFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected method PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/checklist/cl1/checklist_item/i1" {
+			t.Errorf("expected path /checklist/cl1/checklist_item/i1, got %s", r.URL.Path)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["resolved"] != true {
+			t.Errorf("expected only resolved=true, got %v", body)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	resolved := true
+	err := UpdateChecklistItem(client, "cl1", "i1", UpdateChecklistItemRequest{Resolved: &resolved})
+
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestDeleteChecklistItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected method DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/checklist/cl1/checklist_item/i1" {
+			t.Errorf("expected path /checklist/cl1/checklist_item/i1, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	if err := DeleteChecklistItem(client, "cl1", "i1"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
 
 	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
 )
@@ -16,6 +19,13 @@ type Client struct {
 	baseURL    string
 	spaceID    string
 	httpClient *http.Client
+
+	mu       sync.Mutex
+	statuses map[string][]Status
+
+	// dryRunMu keeps the requests printed by concurrent callers whole.
+	dryRunMu sync.Mutex
+	dryRun   io.Writer
 }
 
 func NewClient(apiKey, baseURL, spaceID string) *Client {
@@ -30,7 +40,31 @@ func NewClient(apiKey, baseURL, spaceID string) *Client {
 	}
 }
 
+// SetDryRun makes the client print requests that change data to w instead
+// of sending them. Requests that only read data are still sent, so names
+// can be resolved. A nil w turns dry-run mode off.
+func (c *Client) SetDryRun(w io.Writer) {
+	c.dryRun = w
+}
+
 func Do[Req any, Res any](c *Client, method, path string, body *Req) (Res, error) {
+	return doURL[Req, Res](c, method, c.baseURL+path, body)
+}
+
+// DoV3 is Do against version 3 of the API, which some endpoints exist in
+// only. The v3 base URL is derived from the configured v2 one.
+func DoV3[Req any, Res any](c *Client, method, path string, body *Req) (Res, error) {
+	return doURL[Req, Res](c, method, c.v3BaseURL()+path, body)
+}
+
+func (c *Client) v3BaseURL() string {
+	if base, ok := strings.CutSuffix(c.baseURL, "/v2"); ok {
+		return base + "/v3"
+	}
+	return c.baseURL
+}
+
+func doURL[Req any, Res any](c *Client, method, url string, body *Req) (Res, error) {
 	var zero Res
 
 	var reqBody *bytes.Buffer
@@ -42,12 +76,24 @@ func Do[Req any, Res any](c *Client, method, path string, body *Req) (Res, error
 		reqBody = bytes.NewBuffer(data)
 	}
 
+	if c.dryRun != nil && method != http.MethodGet {
+		var record bytes.Buffer
+		fmt.Fprintf(&record, "%s %s\n", method, url)
+		if reqBody != nil {
+			fmt.Fprintf(&record, "%s\n", reqBody)
+		}
+		c.dryRunMu.Lock()
+		defer c.dryRunMu.Unlock()
+		_, err := c.dryRun.Write(record.Bytes())
+		return zero, err
+	}
+
 	var req *http.Request
 	var err error
 	if reqBody != nil {
-		req, err = http.NewRequest(method, c.baseURL+path, reqBody)
+		req, err = http.NewRequest(method, url, reqBody)
 	} else {
-		req, err = http.NewRequest(method, c.baseURL+path, nil)
+		req, err = http.NewRequest(method, url, nil)
 	}
 	if err != nil {
 		return zero, fmt.Errorf("failed to create request: %w", err)
@@ -79,6 +125,9 @@ func Do[Req any, Res any](c *Client, method, path string, body *Req) (Res, error
 
 	var result Res
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		if err == io.EOF {
+			return zero, nil
+		}
 		return zero, fmt.Errorf("failed to decode response: %w", err)
 	}
 
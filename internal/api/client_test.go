@@ -1,9 +1,12 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 )
 
@@ -219,3 +222,75 @@ func TestErrorImplementsError(t *testing.T) {
 		t.Errorf("expected '%s', got '%s'", expected, err.Error())
 	}
 }
+
+func TestDoDryRunPrintsMutations(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+	var out bytes.Buffer
+	client.SetDryRun(&out)
+
+	_, err := Do[map[string]string, map[string]string](client, http.MethodPut, "/task/abc", &map[string]string{"name": "x"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res, err := Do[any, map[string]string](client, http.MethodGet, "/task/abc", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Errorf("expected only the GET to be sent, got %v", methods)
+	}
+	if res["status"] != "ok" {
+		t.Errorf("expected GET response, got %v", res)
+	}
+	expected := "PUT " + server.URL + "/task/abc\n{\"name\":\"x\"}\n"
+	if out.String() != expected {
+		t.Errorf("expected %q, got %q", expected, out.String())
+	}
+}
+
+// writeRecorder records each write separately.
+type writeRecorder struct {
+	mu     sync.Mutex
+	writes []string
+}
+
+func (w *writeRecorder) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes = append(w.writes, string(p))
+	return len(p), nil
+}
+
+func TestDoDryRunWritesEachRequestWhole(t *testing.T) {
+	client := NewClient("key", "http://example.invalid", "")
+	out := &writeRecorder{}
+	client.SetDryRun(out)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			body := map[string]int{"n": i}
+			Do[map[string]int, any](client, http.MethodPut, fmt.Sprintf("/task/t%d", i), &body)
+		}()
+	}
+	wg.Wait()
+
+	if len(out.writes) != 8 {
+		t.Fatalf("expected one write per request, got %d", len(out.writes))
+	}
+	for _, write := range out.writes {
+		var n int
+		if _, err := fmt.Sscanf(write, "PUT http://example.invalid/task/t%d\n{\"n\":%d}\n", &n, &n); err != nil {
+			t.Errorf("expected the request line and its body together, got %q", write)
+		}
+	}
+}
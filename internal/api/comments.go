@@ -0,0 +1,149 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// CommentRequest is the body for posting a comment or a threaded reply.
+// A comment with an Assignee is an assigned comment, shown to that user
+// as an action item until it is resolved.
+type CommentRequest struct {
+	CommentText string `json:"comment_text"`
+	Assignee    string `json:"assignee,omitempty"`
+	NotifyAll   bool   `json:"notify_all"`
+}
+
+// UpdateCommentRequest is the body for editing a comment. Nil fields are
+// left unchanged.
+type UpdateCommentRequest struct {
+	CommentText *string `json:"comment_text,omitempty"`
+	Assignee    string  `json:"assignee,omitempty"`
+	Resolved    *bool   `json:"resolved,omitempty"`
+}
+
+// CreatedComment is returned when a comment or reply is posted.
+type CreatedComment struct {
+	ID        json.Number `json:"id"`
+	HistoryID string      `json:"hist_id"`
+	Date      json.Number `json:"date"`
+}
+
+func CreateTaskComment(c *Client, taskID string, req CommentRequest) (CreatedComment, error) {
+	path := fmt.Sprintf("/task/%s/comment", taskID)
+	return Do[CommentRequest, CreatedComment](c, http.MethodPost, path, &req)
+}
+
+// ReplyToComment posts req as a threaded reply to commentID.
+func ReplyToComment(c *Client, commentID string, req CommentRequest) (CreatedComment, error) {
+	path := fmt.Sprintf("/comment/%s/reply", commentID)
+	return Do[CommentRequest, CreatedComment](c, http.MethodPost, path, &req)
+}
+
+func UpdateComment(c *Client, commentID string, req UpdateCommentRequest) error {
+	path := fmt.Sprintf("/comment/%s", commentID)
+	_, err := Do[UpdateCommentRequest, any](c, http.MethodPut, path, &req)
+	return err
+}
+
+// commentPageSize is the number of comments ClickUp returns per page.
+const commentPageSize = 25
+
+// errStopPaging ends EachCommentPage early without an error.
+var errStopPaging = errors.New("stop paging")
+
+// EachCommentPage fetches a task's comments page by page, newest first,
+// calling fn as each page arrives, until it reaches the oldest comment.
+// Each page after the first starts before the oldest comment seen so far.
+func EachCommentPage(c *Client, taskID string, fn func([]Comment) error) error {
+	path := fmt.Sprintf("/task/%s/comment", taskID)
+	startID := ""
+	for {
+		resp, err := Do[any, CommentsResponse](c, http.MethodGet, path, nil)
+		if err != nil {
+			return err
+		}
+		if len(resp.Comments) == 0 {
+			return nil
+		}
+		oldest := resp.Comments[len(resp.Comments)-1]
+		if oldest.ID == startID {
+			return nil
+		}
+		if err := fn(resp.Comments); err != nil {
+			if errors.Is(err, errStopPaging) {
+				return nil
+			}
+			return err
+		}
+		if len(resp.Comments) < commentPageSize {
+			return nil
+		}
+		startID = oldest.ID
+		path = fmt.Sprintf("/task/%s/comment?start=%s&start_id=%s", taskID, oldest.DateCreated, oldest.ID)
+	}
+}
+
+// GetAllTaskComments returns every comment of a task, newest first, where
+// GetTaskComments returns only the newest page.
+func GetAllTaskComments(c *Client, taskID string) ([]Comment, error) {
+	var all []Comment
+	err := EachCommentPage(c, taskID, func(comments []Comment) error {
+		all = append(all, comments...)
+		return nil
+	})
+	return all, err
+}
+
+// GetTaskComment finds a comment of a task by ID. ClickUp has no endpoint
+// for a single comment, so it pages back through the task's comments,
+// newest first, until it finds the comment.
+func GetTaskComment(c *Client, taskID, commentID string) (Comment, error) {
+	var found *Comment
+	err := EachCommentPage(c, taskID, func(comments []Comment) error {
+		for _, comment := range comments {
+			if comment.ID == commentID {
+				found = &comment
+				return errStopPaging
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Comment{}, err
+	}
+	if found == nil {
+		return Comment{}, fmt.Errorf("comment %s not found on task %s", commentID, taskID)
+	}
+	return *found, nil
+}
+
+// ResolveComment resolves or reopens a comment. ClickUp requires the
+// comment text on every update, so the comment's text and assignee are
+// sent back unchanged.
+func ResolveComment(c *Client, comment Comment, resolved bool) error {
+	req := UpdateCommentRequest{CommentText: &comment.TextContent, Resolved: &resolved}
+	if comment.Assignee != nil {
+		req.Assignee = comment.Assignee.ID
+	}
+	return UpdateComment(c, comment.ID, req)
+}
+
+// EditComment replaces the text of a comment. ClickUp resets what an
+// update leaves out, so the comment's resolved state is sent back
+// unchanged, and its assignee too unless assignee replaces it.
+func EditComment(c *Client, comment Comment, text, assignee string) error {
+	req := UpdateCommentRequest{CommentText: &text, Assignee: assignee, Resolved: &comment.Resolved}
+	if req.Assignee == "" && comment.Assignee != nil {
+		req.Assignee = comment.Assignee.ID
+	}
+	return UpdateComment(c, comment.ID, req)
+}
+
+func DeleteComment(c *Client, commentID string) error {
+	path := fmt.Sprintf("/comment/%s", commentID)
+	_, err := Do[any, any](c, http.MethodDelete, path, nil)
+	return err
+}
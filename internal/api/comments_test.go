@@ -0,0 +1,229 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateTaskComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/task/abc123/comment" {
+			t.Errorf("expected path /task/abc123/comment, got %s", r.URL.Path)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["comment_text"] != "Looks good" {
+			t.Errorf("expected comment_text 'Looks good', got %v", body["comment_text"])
+		}
+		if body["assignee"] != "42" {
+			t.Errorf("expected assignee '42', got %v", body["assignee"])
+		}
+		if body["notify_all"] != true {
+			t.Errorf("expected notify_all true, got %v", body["notify_all"])
+		}
+		w.Write([]byte(`{"id": 458, "hist_id": "26508", "date": 1568036964079}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	created, err := CreateTaskComment(client, "abc123", CommentRequest{
+		CommentText: "Looks good",
+		Assignee:    "42",
+		NotifyAll:   true,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.ID != "458" {
+		t.Errorf("expected id 458, got %s", created.ID)
+	}
+}
+
+func TestReplyToComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/comment/458/reply" {
+			t.Errorf("expected path /comment/458/reply, got %s", r.URL.Path)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["assignee"]; ok {
+			t.Errorf("expected no assignee, got %v", body["assignee"])
+		}
+		w.Write([]byte(`{"id": 459}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	created, err := ReplyToComment(client, "458", CommentRequest{CommentText: "Thanks"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.ID != "459" {
+		t.Errorf("expected id 459, got %s", created.ID)
+	}
+}
+
+func TestUpdateCommentSendsOnlySetFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected method PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/comment/458" {
+			t.Errorf("expected path /comment/458, got %s", r.URL.Path)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["comment_text"] != "Edited" {
+			t.Errorf("expected only comment_text, got %v", body)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	text := "Edited"
+	err := UpdateComment(client, "458", UpdateCommentRequest{CommentText: &text})
+
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestEditComment(t *testing.T) {
+	tests := []struct {
+		assignee string
+		expected string
+	}{
+		{"", "7"},
+		{"8", "8"},
+	}
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["comment_text"] != "Edited" || body["resolved"] != true || body["assignee"] != tt.expected {
+				t.Errorf("expected the new text with resolved true and assignee %s, got %v", tt.expected, body)
+			}
+			w.Write([]byte(`{}`))
+		}))
+		client := NewClient("key", server.URL, "")
+		comment := Comment{ID: "458", TextContent: "Ship it", Assignee: &User{ID: "7"}, Resolved: true}
+
+		if err := EditComment(client, comment, "Edited", tt.assignee); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		server.Close()
+	}
+}
+
+func TestResolveComment(t *testing.T) {
+	for _, resolved := range []bool{true, false} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/comment/458" {
+				t.Errorf("expected path /comment/458, got %s", r.URL.Path)
+			}
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["resolved"] != resolved || body["comment_text"] != "Ship it" || body["assignee"] != "7" {
+				t.Errorf("expected resolved=%v with the comment's text and assignee, got %v", resolved, body)
+			}
+			w.Write([]byte(`{}`))
+		}))
+		client := NewClient("key", server.URL, "")
+		comment := Comment{ID: "458", TextContent: "Ship it", Assignee: &User{ID: "7"}}
+
+		if err := ResolveComment(client, comment, resolved); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		server.Close()
+	}
+}
+
+func TestGetTaskCommentPages(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("start_id") == "" {
+			var comments []string
+			for i := 0; i < 25; i++ {
+				comments = append(comments, fmt.Sprintf(`{"id": "%d", "date_created": "%d"}`, 100-i, 1000-i))
+			}
+			fmt.Fprintf(w, `{"comments": [%s]}`, strings.Join(comments, ","))
+			return
+		}
+		w.Write([]byte(`{"comments": [{"id": "12", "text_content": "Old"}]}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	comment, err := GetTaskComment(client, "abc", "12")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if comment.TextContent != "Old" {
+		t.Errorf("expected the comment from the second page, got %+v", comment)
+	}
+	if len(queries) != 2 || queries[1] != "start=976&start_id=76" {
+		t.Errorf("expected a second page starting after the oldest comment, got %v", queries)
+	}
+
+	if _, err := GetTaskComment(client, "abc", "missing"); err == nil {
+		t.Error("expected an error for a comment not on the task")
+	}
+}
+
+func TestGetAllTaskComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start_id") == "" {
+			var comments []string
+			for i := 0; i < 25; i++ {
+				comments = append(comments, fmt.Sprintf(`{"id": "%d", "date_created": "%d"}`, 100-i, 1000-i))
+			}
+			fmt.Fprintf(w, `{"comments": [%s]}`, strings.Join(comments, ","))
+			return
+		}
+		w.Write([]byte(`{"comments": [{"id": "12"}, {"id": "11"}]}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	comments, err := GetAllTaskComments(client, "abc")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(comments) != 27 || comments[26].ID != "11" {
+		t.Errorf("expected all 27 comments, oldest last, got %d", len(comments))
+	}
+}
+
+func TestDeleteComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected method DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/comment/458" {
+			t.Errorf("expected path /comment/458, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	if err := DeleteComment(client, "458"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
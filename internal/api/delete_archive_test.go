@@ -1,6 +1,7 @@
 package api
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -97,3 +98,27 @@ func TestArchiveTaskNotFound(t *testing.T) {
 		t.Errorf("expected message 'Task not found', got '%s'", apiErr.Message)
 	}
 }
+
+func TestUnarchiveTaskSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected method PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/task/abc123" {
+			t.Errorf("expected path /task/abc123, got %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"archived":false}` {
+			t.Errorf("expected archived false, got %s", body)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	err := UnarchiveTask(client, "abc123")
+
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -11,6 +11,10 @@ type FoldersResponse struct {
 	Folders []Folder `json:"folders"`
 }
 
+func GetFolder(c *Client, folderID string) (Folder, error) {
+	return Do[any, Folder](c, http.MethodGet, "/folder/"+folderID, nil)
+}
+
 func GetFolders(c *Client, spaceID string) ([]Folder, error) {
 	resp, err := Do[any, FoldersResponse](c, http.MethodGet, "/space/"+spaceID+"/folder", nil)
 	if err != nil {
@@ -18,3 +22,11 @@ func GetFolders(c *Client, spaceID string) ([]Folder, error) {
 	}
 	return resp.Folders, nil
 }
+
+type CreateFolderRequest struct {
+	Name string `json:"name"`
+}
+
+func CreateFolder(c *Client, spaceID string, req CreateFolderRequest) (Folder, error) {
+	return Do[CreateFolderRequest, Folder](c, http.MethodPost, "/space/"+spaceID+"/folder", &req)
+}
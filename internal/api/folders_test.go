@@ -94,3 +94,45 @@ func TestGetFoldersError(t *testing.T) {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestGetFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/folder/456" {
+			t.Errorf("expected path /folder/456, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"id": "456", "name": "Engineering"}`))
+	}))
+	defer server.Close()
+	client := NewClient("test-key", server.URL, "")
+
+	folder, err := GetFolder(client, "456")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if folder.Name != "Engineering" {
+		t.Errorf("expected name 'Engineering', got '%s'", folder.Name)
+	}
+}
+
+func TestCreateFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/space/123/folder" {
+			t.Errorf("expected POST /space/123/folder, got %s %s", r.Method, r.URL.Path)
+		}
+		var body CreateFolderRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"id": "456", "name": "` + body.Name + `"}`))
+	}))
+	defer server.Close()
+	client := NewClient("test-key", server.URL, "")
+
+	folder, err := CreateFolder(client, "123", CreateFolderRequest{Name: "Engineering"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if folder.ID != "456" || folder.Name != "Engineering" {
+		t.Errorf("unexpected folder %+v", folder)
+	}
+}
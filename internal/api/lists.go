@@ -11,6 +11,19 @@ type ListsResponse struct {
 	Lists []List `json:"lists"`
 }
 
+func GetList(c *Client, listID string) (List, error) {
+	return Do[any, List](c, http.MethodGet, "/list/"+listID, nil)
+}
+
+// GetFolderlessLists returns the lists of a space that aren't in a folder.
+func GetFolderlessLists(c *Client, spaceID string) ([]List, error) {
+	resp, err := Do[any, ListsResponse](c, http.MethodGet, "/space/"+spaceID+"/list", nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Lists, nil
+}
+
 func GetLists(c *Client, folderID string) ([]List, error) {
 	resp, err := Do[any, ListsResponse](c, http.MethodGet, "/folder/"+folderID+"/list", nil)
 	if err != nil {
@@ -18,3 +31,11 @@ func GetLists(c *Client, folderID string) ([]List, error) {
 	}
 	return resp.Lists, nil
 }
+
+type CreateListRequest struct {
+	Name string `json:"name"`
+}
+
+func CreateList(c *Client, folderID string, req CreateListRequest) (List, error) {
+	return Do[CreateListRequest, List](c, http.MethodPost, "/folder/"+folderID+"/list", &req)
+}
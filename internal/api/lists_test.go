@@ -94,3 +94,65 @@ func TestGetListsError(t *testing.T) {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestGetList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/list/list1" {
+			t.Errorf("expected path /list/list1, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"id": "list1", "name": "Backlog"}`))
+	}))
+	defer server.Close()
+	client := NewClient("test-key", server.URL, "")
+
+	list, err := GetList(client, "list1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.Name != "Backlog" {
+		t.Errorf("expected name 'Backlog', got '%s'", list.Name)
+	}
+}
+
+func TestGetFolderlessLists(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/space/123/list" {
+			t.Errorf("expected path /space/123/list, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"lists": [{"id": "list9", "name": "Inbox"}]}`))
+	}))
+	defer server.Close()
+	client := NewClient("test-key", server.URL, "")
+
+	lists, err := GetFolderlessLists(client, "123")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(lists) != 1 || lists[0].ID != "list9" {
+		t.Errorf("expected list9, got %+v", lists)
+	}
+}
+
+func TestCreateList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/folder/456/list" {
+			t.Errorf("expected POST /folder/456/list, got %s %s", r.Method, r.URL.Path)
+		}
+		var body CreateListRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"id": "list1", "name": "` + body.Name + `"}`))
+	}))
+	defer server.Close()
+	client := NewClient("test-key", server.URL, "")
+
+	list, err := CreateList(client, "456", CreateListRequest{Name: "Backlog"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.ID != "list1" || list.Name != "Backlog" {
+		t.Errorf("unexpected list %+v", list)
+	}
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Workspace struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type WorkspacesResponse struct {
+	Workspaces []Workspace `json:"teams"`
+}
+
+// GetWorkspaces returns the workspaces (called teams in API v2) the API
+// key has access to.
+func GetWorkspaces(c *Client) ([]Workspace, error) {
+	resp, err := Do[any, WorkspacesResponse](c, http.MethodGet, "/team", nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Workspaces, nil
+}
+
+// StatusMapping moves tasks in SourceStatus to DestinationStatus when
+// they change lists.
+type StatusMapping struct {
+	SourceStatus      string `json:"source_status"`
+	DestinationStatus string `json:"destination_status"`
+}
+
+type MoveTaskRequest struct {
+	StatusMappings []StatusMapping `json:"status_mappings,omitempty"`
+}
+
+// MoveTask changes the home list of a task, keeping its history. The move
+// endpoint only exists in API v3, which addresses tasks by workspace.
+func MoveTask(c *Client, workspaceID, taskID, listID string, req MoveTaskRequest) error {
+	path := fmt.Sprintf("/workspaces/%s/tasks/%s/home_list/%s", workspaceID, taskID, listID)
+	_, err := DoV3[MoveTaskRequest, any](c, http.MethodPut, path, &req)
+	return err
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetWorkspaces(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/team" {
+			t.Errorf("expected path /team, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"teams": [{"id": "9001", "name": "Acme"}]}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	workspaces, err := GetWorkspaces(client)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(workspaces) != 1 || workspaces[0].ID != "9001" {
+		t.Errorf("expected workspace 9001, got %+v", workspaces)
+	}
+}
+
+func TestMoveTaskUsesV3Endpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected method PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v3/workspaces/9001/tasks/task123/home_list/list456" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var body MoveTaskRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body.StatusMappings) != 1 || body.StatusMappings[0].DestinationStatus != "review" {
+			t.Errorf("unexpected status mappings %+v", body.StatusMappings)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL+"/api/v2", "")
+
+	err := MoveTask(client, "9001", "task123", "list456", MoveTaskRequest{
+		StatusMappings: []StatusMapping{{SourceStatus: "in review", DestinationStatus: "review"}},
+	})
+
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
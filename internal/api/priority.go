@@ -0,0 +1,40 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Task priorities as the API numbers them. PriorityNone clears a priority.
+const (
+	PriorityNone   = 0
+	PriorityUrgent = 1
+	PriorityHigh   = 2
+	PriorityNormal = 3
+	PriorityLow    = 4
+)
+
+var priorityNames = map[string]int{
+	"none":   PriorityNone,
+	"urgent": PriorityUrgent,
+	"high":   PriorityHigh,
+	"normal": PriorityNormal,
+	"low":    PriorityLow,
+}
+
+// ParsePriority parses a priority given by name (urgent, high, normal, low,
+// none) or by number (0-4) into the API value. An empty value is none.
+func ParsePriority(value string) (int, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return PriorityNone, nil
+	}
+	if level, ok := priorityNames[value]; ok {
+		return level, nil
+	}
+	if level, err := strconv.Atoi(value); err == nil && level >= PriorityNone && level <= PriorityLow {
+		return level, nil
+	}
+	return 0, fmt.Errorf("invalid priority %q (valid priorities: urgent, high, normal, low, none, or 0-4)", value)
+}
@@ -0,0 +1,35 @@
+package api
+
+import "testing"
+
+func TestParsePriority(t *testing.T) {
+	tests := map[string]int{
+		"urgent": PriorityUrgent,
+		"High":   PriorityHigh,
+		"normal": PriorityNormal,
+		" low ":  PriorityLow,
+		"none":   PriorityNone,
+		"":       PriorityNone,
+		"1":      PriorityUrgent,
+		"4":      PriorityLow,
+		"0":      PriorityNone,
+	}
+	for value, want := range tests {
+		got, err := ParsePriority(value)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", value, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%q: expected %d, got %d", value, want, got)
+		}
+	}
+}
+
+func TestParsePriorityInvalid(t *testing.T) {
+	for _, value := range []string{"5", "-1", "critical", "1.5"} {
+		if _, err := ParsePriority(value); err == nil {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+}
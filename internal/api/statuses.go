@@ -0,0 +1,84 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Status is one of the statuses a list's tasks can be in. Type is open,
+// custom, done, or closed.
+type Status struct {
+	ID         string `json:"id"`
+	Status     string `json:"status"`
+	Color      string `json:"color"`
+	Type       string `json:"type"`
+	OrderIndex int    `json:"orderindex"`
+}
+
+type listStatusesResponse struct {
+	Statuses []Status `json:"statuses"`
+}
+
+// GetListStatuses returns a list's statuses in workflow order. Statuses are
+// cached per list for the lifetime of the client.
+func GetListStatuses(c *Client, listID string) ([]Status, error) {
+	c.mu.Lock()
+	statuses, ok := c.statuses[listID]
+	c.mu.Unlock()
+	if ok {
+		return statuses, nil
+	}
+
+	resp, err := Do[any, listStatusesResponse](c, http.MethodGet, "/list/"+listID, nil)
+	if err != nil {
+		return nil, err
+	}
+	statuses = resp.Statuses
+	slices.SortStableFunc(statuses, func(a, b Status) int {
+		return a.OrderIndex - b.OrderIndex
+	})
+
+	c.mu.Lock()
+	if c.statuses == nil {
+		c.statuses = make(map[string][]Status)
+	}
+	c.statuses[listID] = statuses
+	c.mu.Unlock()
+	return statuses, nil
+}
+
+// MatchStatus finds the status named by input, ignoring case. A prefix
+// matching exactly one status is accepted too, so "in prog" finds
+// "in progress".
+func MatchStatus(statuses []Status, input string) (Status, error) {
+	query := strings.ToLower(strings.TrimSpace(input))
+
+	var matches []Status
+	for _, status := range statuses {
+		name := strings.ToLower(status.Status)
+		if name == query {
+			return status, nil
+		}
+		if query != "" && strings.HasPrefix(name, query) {
+			matches = append(matches, status)
+		}
+	}
+
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+	if len(matches) > 1 {
+		return Status{}, fmt.Errorf("status %q is ambiguous (matches: %s)", input, statusNames(matches))
+	}
+	return Status{}, fmt.Errorf("invalid status %q (valid statuses: %s)", input, statusNames(statuses))
+}
+
+func statusNames(statuses []Status) string {
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = status.Status
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetListStatusesSortsAndCaches(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/list/list1" {
+			t.Errorf("expected path /list/list1, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"id": "list1", "statuses": [
+			{"status": "complete", "type": "closed", "orderindex": 2},
+			{"status": "to do", "type": "open", "orderindex": 0},
+			{"status": "in progress", "type": "custom", "orderindex": 1}
+		]}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	statuses, err := GetListStatuses(client, "list1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := GetListStatuses(client, "list1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected statuses to be fetched once, got %d calls", calls)
+	}
+	if len(statuses) != 3 || statuses[0].Status != "to do" || statuses[2].Status != "complete" {
+		t.Errorf("expected statuses in workflow order, got %+v", statuses)
+	}
+}
+
+func TestMatchStatus(t *testing.T) {
+	statuses := []Status{
+		{Status: "to do"},
+		{Status: "in progress"},
+		{Status: "in review"},
+		{Status: "done"},
+	}
+
+	tests := map[string]string{
+		"done":        "done",
+		"IN PROGRESS": "in progress",
+		"in p":        "in progress",
+		"t":           "to do",
+	}
+	for input, want := range tests {
+		got, err := MatchStatus(statuses, input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", input, err)
+			continue
+		}
+		if got.Status != want {
+			t.Errorf("%q: expected %q, got %q", input, want, got.Status)
+		}
+	}
+}
+
+func TestMatchStatusErrors(t *testing.T) {
+	statuses := []Status{{Status: "to do"}, {Status: "in progress"}, {Status: "in review"}}
+
+	_, err := MatchStatus(statuses, "in")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected ambiguous error, got %v", err)
+	}
+
+	_, err = MatchStatus(statuses, "in progres s")
+	if err == nil || !strings.Contains(err.Error(), "valid statuses: to do, in progress, in review") {
+		t.Errorf("expected error listing valid statuses, got %v", err)
+	}
+}
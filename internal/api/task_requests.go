@@ -0,0 +1,111 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CustomFieldValue sets the custom field with the given ID.
+type CustomFieldValue struct {
+	ID    string `json:"id"`
+	Value any    `json:"value"`
+}
+
+// CreateTaskRequest is the body for creating a task in ListID. Dates are
+// milliseconds since the epoch and TimeEstimate is in milliseconds.
+type CreateTaskRequest struct {
+	ListID              string             `json:"-"`
+	Name                string             `json:"name"`
+	Description         string             `json:"description,omitempty"`
+	MarkdownDescription string             `json:"markdown_description,omitempty"`
+	Assignees           []string           `json:"assignees,omitempty"`
+	Tags                []string           `json:"tags,omitempty"`
+	Status              string             `json:"status,omitempty"`
+	Priority            int                `json:"priority,omitempty"`
+	DueDate             int64              `json:"due_date,omitempty"`
+	DueDateTime         bool               `json:"due_date_time,omitempty"`
+	StartDate           int64              `json:"start_date,omitempty"`
+	StartDateTime       bool               `json:"start_date_time,omitempty"`
+	TimeEstimate        int64              `json:"time_estimate,omitempty"`
+	Points              *float64           `json:"points,omitempty"`
+	NotifyAll           bool               `json:"notify_all,omitempty"`
+	Parent              string             `json:"parent,omitempty"`
+	LinksTo             string             `json:"links_to,omitempty"`
+	CustomFields        []CustomFieldValue `json:"custom_fields,omitempty"`
+}
+
+// AssigneesUpdate adds and removes task assignees by user ID.
+type AssigneesUpdate struct {
+	Add []string `json:"add"`
+	Rem []string `json:"rem"`
+}
+
+// UpdateTaskRequest is the body for updating a task. Nil fields are left
+// unchanged. A pointer to zero clears Priority, DueDate, StartDate,
+// TimeEstimate, or Points.
+type UpdateTaskRequest struct {
+	Name                *string          `json:"name,omitempty"`
+	Description         *string          `json:"description,omitempty"`
+	MarkdownDescription *string          `json:"markdown_description,omitempty"`
+	Status              *string          `json:"status,omitempty"`
+	Priority            *int             `json:"priority,omitempty"`
+	DueDate             *int64           `json:"due_date,omitempty"`
+	DueDateTime         *bool            `json:"due_date_time,omitempty"`
+	StartDate           *int64           `json:"start_date,omitempty"`
+	StartDateTime       *bool            `json:"start_date_time,omitempty"`
+	TimeEstimate        *int64           `json:"time_estimate,omitempty"`
+	Points              *float64         `json:"points,omitempty"`
+	Parent              *string          `json:"parent,omitempty"`
+	Assignees           *AssigneesUpdate `json:"assignees,omitempty"`
+}
+
+// clearableTaskFields are sent as null when set to zero, which ClickUp
+// treats as removing the value.
+var clearableTaskFields = []string{"priority", "due_date", "start_date", "time_estimate", "points"}
+
+func (r UpdateTaskRequest) MarshalJSON() ([]byte, error) {
+	type plain UpdateTaskRequest
+	data, err := json.Marshal(plain(r))
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for _, name := range clearableTaskFields {
+		if string(fields[name]) == "0" {
+			fields[name] = json.RawMessage("null")
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// IsEmpty reports whether the request would not change anything.
+func (r UpdateTaskRequest) IsEmpty() bool {
+	return r == UpdateTaskRequest{}
+}
+
+func AddTaskTag(c *Client, taskID, tag string) error {
+	path := fmt.Sprintf("/task/%s/tag/%s", taskID, url.PathEscape(tag))
+	_, err := Do[any, any](c, http.MethodPost, path, nil)
+	return err
+}
+
+func RemoveTaskTag(c *Client, taskID, tag string) error {
+	path := fmt.Sprintf("/task/%s/tag/%s", taskID, url.PathEscape(tag))
+	_, err := Do[any, any](c, http.MethodDelete, path, nil)
+	return err
+}
+
+// SetCustomField sets the value of a custom field on a task. Custom fields
+// cannot be changed through UpdateTask.
+func SetCustomField(c *Client, taskID string, field CustomFieldValue) error {
+	path := fmt.Sprintf("/task/%s/field/%s", taskID, field.ID)
+	body := map[string]any{"value": field.Value}
+	_, err := Do[map[string]any, any](c, http.MethodPost, path, &body)
+	return err
+}
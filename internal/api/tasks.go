@@ -14,6 +14,12 @@ type User struct {
 	Avatar   string `json:"avatar"`
 }
 
+type Tag struct {
+	Name  string `json:"name"`
+	TagFg string `json:"tag_fg"`
+	TagBg string `json:"tag_bg"`
+}
+
 type Dependency struct {
 	TaskID   string `json:"task_id"`
 	DependsOn string `json:"depends_on"`
@@ -26,6 +32,7 @@ type Task struct {
 	Name        string         `json:"name"`
 	TextContent string         `json:"text_content"`
 	Description string         `json:"description"`
+	MarkdownDescription string `json:"markdown_description"`
 	Status      *struct {
 		ID      string `json:"id"`
 		Status  string `json:"status"`
@@ -48,6 +55,9 @@ type Task struct {
 	Assignees []User `json:"assignees"`
 	ParentID string `json:"parent"`
 	ListID   string `json:"list"`
+	Tags     []Tag  `json:"tags"`
+	URL      string `json:"url"`
+	Checklists []Checklist `json:"checklists"`
 	Subtasks []Task `json:"subtasks"`
 }
 
@@ -56,6 +66,7 @@ type Comment struct {
 	HistoryID    string `json:"history_id"`
 	TextContent  string `json:"text_content"`
 	User         User   `json:"user"`
+	Assignee     *User  `json:"assignee"`
 	Resolved     bool   `json:"resolved"`
 	DateCreated  string `json:"date_created"`
 	DateUpdated  string `json:"date_updated"`
@@ -66,19 +77,65 @@ type CommentsResponse struct {
 }
 
 type TaskListResponse struct {
-	Tasks []Task `json:"tasks"`
+	Tasks    []Task `json:"tasks"`
+	LastPage bool   `json:"last_page"`
+}
+
+// TaskListOptions controls which page of a list's tasks is fetched.
+// Archived fetches the list's archived tasks instead of its active ones.
+// UpdatedAfter, a timestamp in milliseconds, skips tasks not updated since.
+type TaskListOptions struct {
+	Subtasks      bool
+	Archived      bool
+	IncludeClosed bool
+	UpdatedAfter  int64
+	Page          int
 }
 
 func GetTasks(c *Client, listID string, recursive bool) (TaskListResponse, error) {
-	path := fmt.Sprintf("/list/%s/task?archived=false", listID)
-	if recursive {
+	return ListTasks(c, listID, TaskListOptions{Subtasks: recursive})
+}
+
+func ListTasks(c *Client, listID string, opts TaskListOptions) (TaskListResponse, error) {
+	path := fmt.Sprintf("/list/%s/task?archived=%t", listID, opts.Archived)
+	if opts.Subtasks {
 		path += "&subtasks=true"
 	}
+	if opts.IncludeClosed {
+		path += "&include_closed=true"
+	}
+	if opts.UpdatedAfter > 0 {
+		path += fmt.Sprintf("&date_updated_gt=%d", opts.UpdatedAfter)
+	}
+	if opts.Page > 0 {
+		path += fmt.Sprintf("&page=%d", opts.Page)
+	}
 	return Do[any, TaskListResponse](c, http.MethodGet, path, nil)
 }
 
+// EachTaskPage fetches a list's tasks page by page, calling fn as each
+// page arrives, until the API reports the last page.
+func EachTaskPage(c *Client, listID string, opts TaskListOptions, fn func([]Task) error) error {
+	for page := opts.Page; ; page++ {
+		opts.Page = page
+		resp, err := ListTasks(c, listID, opts)
+		if err != nil {
+			return err
+		}
+		if len(resp.Tasks) == 0 {
+			return nil
+		}
+		if err := fn(resp.Tasks); err != nil {
+			return err
+		}
+		if resp.LastPage {
+			return nil
+		}
+	}
+}
+
 func GetTask(c *Client, taskID string) (Task, error) {
-	path := fmt.Sprintf("/task/%s", taskID)
+	path := fmt.Sprintf("/task/%s?include_subtasks=true&include_markdown_description=true", taskID)
 	return Do[any, Task](c, http.MethodGet, path, nil)
 }
 
@@ -91,16 +148,15 @@ func GetTaskComments(c *Client, taskID string) ([]Comment, error) {
 	return resp.Comments, nil
 }
 
-func CreateTask(c *Client, payload map[string]any) (Task, error) {
+func CreateTask(c *Client, req CreateTaskRequest) (Task, error) {
 	var task Task
 
-	listID, ok := payload["list_id"].(string)
-	if !ok || listID == "" {
+	if req.ListID == "" {
 		return task, fmt.Errorf("list_id is required")
 	}
 
-	path := fmt.Sprintf("/list/%s/task", listID)
-	return Do[map[string]any, Task](c, http.MethodPost, path, &payload)
+	path := fmt.Sprintf("/list/%s/task", req.ListID)
+	return Do[CreateTaskRequest, Task](c, http.MethodPost, path, &req)
 }
 
 func DeleteTask(c *Client, taskID string) error {
@@ -115,7 +171,19 @@ func ArchiveTask(c *Client, taskID string) error {
 	return err
 }
 
-func UpdateTask(c *Client, taskID string, payload map[string]any) (Task, error) {
+// UnarchiveTask restores an archived task. There is no unarchive endpoint;
+// the task is updated instead.
+func UnarchiveTask(c *Client, taskID string) error {
+	path := fmt.Sprintf("/task/%s", taskID)
+	_, err := Do[archivedRequest, any](c, http.MethodPut, path, &archivedRequest{Archived: false})
+	return err
+}
+
+type archivedRequest struct {
+	Archived bool `json:"archived"`
+}
+
+func UpdateTask(c *Client, taskID string, req UpdateTaskRequest) (Task, error) {
 	path := fmt.Sprintf("/task/%s", taskID)
-	return Do[map[string]any, Task](c, http.MethodPut, path, &payload)
+	return Do[UpdateTaskRequest, Task](c, http.MethodPut, path, &req)
 }
@@ -98,9 +98,9 @@ func TestCreateTask(t *testing.T) {
 	defer server.Close()
 	client := NewClient("key", server.URL, "")
 
-	payload := map[string]any{
-		"name":    "New Task",
-		"list_id": "list-456",
+	payload := CreateTaskRequest{
+		Name:   "New Task",
+		ListID: "list-456",
 	}
 	result, err := CreateTask(client, payload)
 
@@ -130,9 +130,9 @@ func TestCreateTaskCallsCorrectPath(t *testing.T) {
 	defer server.Close()
 	client := NewClient("key", server.URL, "")
 
-	payload := map[string]any{
-		"name":    "New Task",
-		"list_id": "list-456",
+	payload := CreateTaskRequest{
+		Name:   "New Task",
+		ListID: "list-456",
 	}
 	CreateTask(client, payload)
 
@@ -158,14 +158,14 @@ func TestCreateTaskWithOptionalFields(t *testing.T) {
 	defer server.Close()
 	client := NewClient("key", server.URL, "")
 
-	payload := map[string]any{
-		"name":        "New Task with Details",
-		"list_id":     "list-456",
-		"description": "Test description",
-		"priority":    1,
-		"status":      "to do",
-		"due_date":    1234567890000,
-		"parent":      "parent-789",
+	payload := CreateTaskRequest{
+		Name:        "New Task with Details",
+		ListID:      "list-456",
+		Description: "Test description",
+		Priority:    1,
+		Status:      "to do",
+		DueDate:     1234567890000,
+		Parent:      "parent-789",
 	}
 	result, err := CreateTask(client, payload)
 
@@ -183,11 +183,68 @@ func TestCreateTaskWithOptionalFields(t *testing.T) {
 	}
 }
 
+func TestCreateTaskSendsFullPayload(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"id": "task-123"}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	points := 2.0
+	_, err := CreateTask(client, CreateTaskRequest{
+		ListID:              "list-456",
+		Name:                "Release",
+		MarkdownDescription: "# Notes",
+		Assignees:           []string{"1", "2"},
+		Tags:                []string{"release"},
+		StartDate:           1735689600000,
+		TimeEstimate:        5400000,
+		Points:              &points,
+		NotifyAll:           true,
+		LinksTo:             "task-9",
+		CustomFields:        []CustomFieldValue{{ID: "field-1", Value: "high"}},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := body["list_id"]; ok {
+		t.Error("expected list ID to be sent in the path only")
+	}
+	expected := map[string]any{
+		"name":                 "Release",
+		"markdown_description": "# Notes",
+		"start_date":           float64(1735689600000),
+		"time_estimate":        float64(5400000),
+		"points":               2.0,
+		"notify_all":           true,
+		"links_to":             "task-9",
+	}
+	for key, want := range expected {
+		if body[key] != want {
+			t.Errorf("expected %s=%v, got %v", key, want, body[key])
+		}
+	}
+	if assignees, _ := body["assignees"].([]any); len(assignees) != 2 {
+		t.Errorf("expected 2 assignees, got %v", body["assignees"])
+	}
+	if fields, _ := body["custom_fields"].([]any); len(fields) != 1 {
+		t.Errorf("expected 1 custom field, got %v", body["custom_fields"])
+	}
+	for _, key := range []string{"description", "priority", "due_date", "parent"} {
+		if _, ok := body[key]; ok {
+			t.Errorf("expected unset %s to be omitted", key)
+		}
+	}
+}
+
 func TestCreateTaskMissingListID(t *testing.T) {
 	client := NewClient("key", "http://localhost", "")
 
-	payload := map[string]any{
-		"name": "Task without list",
+	payload := CreateTaskRequest{
+		Name: "Task without list",
 	}
 	_, err := CreateTask(client, payload)
 
@@ -207,8 +264,8 @@ func TestCreateTaskMissingNameField(t *testing.T) {
 	defer server.Close()
 	client := NewClient("key", server.URL, "")
 
-	payload := map[string]any{
-		"list_id": "list-456",
+	payload := CreateTaskRequest{
+		ListID: "list-456",
 	}
 	result, err := CreateTask(client, payload)
 
@@ -460,3 +517,113 @@ func TestGetTasksWithMultipleRootsAndChildren(t *testing.T) {
 		t.Errorf("expected 0 subtasks for root2, got %d", len(root2.Subtasks))
 	}
 }
+
+func TestListTasksWithPage(t *testing.T) {
+	var capturedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedPath = r.RequestURI
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"tasks": []}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	ListTasks(client, "list123", TaskListOptions{Subtasks: true, Page: 2})
+
+	if capturedPath != "/list/list123/task?archived=false&subtasks=true&page=2" {
+		t.Errorf("expected path with page=2, got '%s'", capturedPath)
+	}
+}
+
+func TestEachTaskPageStopsOnLastPage(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		w.WriteHeader(http.StatusOK)
+		if page == "1" {
+			w.Write([]byte(`{"tasks": [{"id": "task2"}], "last_page": true}`))
+			return
+		}
+		w.Write([]byte(`{"tasks": [{"id": "task1"}], "last_page": false}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	var ids []string
+	err := EachTaskPage(client, "list123", TaskListOptions{}, func(tasks []Task) error {
+		for _, task := range tasks {
+			ids = append(ids, task.ID)
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pages) != 2 {
+		t.Fatalf("expected 2 page requests, got %d", len(pages))
+	}
+	if len(ids) != 2 || ids[0] != "task1" || ids[1] != "task2" {
+		t.Errorf("expected tasks from both pages in order, got %v", ids)
+	}
+}
+
+func TestEachTaskPageStopsOnEmptyPage(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"tasks": []}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	called := false
+	err := EachTaskPage(client, "list123", TaskListOptions{}, func(tasks []Task) error {
+		called = true
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+	if called {
+		t.Error("callback should not run for an empty page")
+	}
+}
+
+func TestListTasksArchived(t *testing.T) {
+	var capturedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedPath = r.RequestURI
+		w.Write([]byte(`{"tasks": []}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	ListTasks(client, "list123", TaskListOptions{Archived: true})
+
+	if capturedPath != "/list/list123/task?archived=true" {
+		t.Errorf("expected archived tasks path, got '%s'", capturedPath)
+	}
+}
+
+func TestListTasksIncremental(t *testing.T) {
+	var capturedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedPath = r.RequestURI
+		w.Write([]byte(`{"tasks": []}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	ListTasks(client, "list123", TaskListOptions{IncludeClosed: true, UpdatedAfter: 1700000000000})
+
+	if capturedPath != "/list/list123/task?archived=false&include_closed=true&date_updated_gt=1700000000000" {
+		t.Errorf("unexpected path '%s'", capturedPath)
+	}
+}
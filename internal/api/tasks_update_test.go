@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -20,9 +21,10 @@ func TestUpdateTask(t *testing.T) {
 	defer server.Close()
 	client := NewClient("key", server.URL, "")
 
-	payload := map[string]any{
-		"name":     "Updated Task",
-		"assignee": "user456",
+	name := "Updated Task"
+	payload := UpdateTaskRequest{
+		Name:      &name,
+		Assignees: &AssigneesUpdate{Add: []string{"user456"}},
 	}
 
 	result, err := UpdateTask(client, "task123", payload)
@@ -52,9 +54,8 @@ func TestUpdateTaskPartialUpdate(t *testing.T) {
 	defer server.Close()
 	client := NewClient("key", server.URL, "")
 
-	payload := map[string]any{
-		"status": "completed",
-	}
+	status := "completed"
+	payload := UpdateTaskRequest{Status: &status}
 
 	result, err := UpdateTask(client, "task456", payload)
 
@@ -74,7 +75,8 @@ func TestUpdateTaskNotFound(t *testing.T) {
 	defer server.Close()
 	client := NewClient("key", server.URL, "")
 
-	payload := map[string]any{"name": "Updated"}
+	name := "Updated"
+	payload := UpdateTaskRequest{Name: &name}
 
 	_, err := UpdateTask(client, "notfound", payload)
 
@@ -101,13 +103,15 @@ func TestUpdateTaskAllFields(t *testing.T) {
 	defer server.Close()
 	client := NewClient("key", server.URL, "")
 
-	payload := map[string]any{
-		"name":        "Complete Update",
-		"assignee":    "user789",
-		"status":      "in review",
-		"priority":    5,
-		"due_date":    "2025-12-31",
-		"description": "Updated description",
+	name, status, description := "Complete Update", "in review", "Updated description"
+	priority, dueDate := 4, int64(1767139200000)
+	payload := UpdateTaskRequest{
+		Name:        &name,
+		Assignees:   &AssigneesUpdate{Add: []string{"user789"}},
+		Status:      &status,
+		Priority:    &priority,
+		DueDate:     &dueDate,
+		Description: &description,
 	}
 
 	result, err := UpdateTask(client, "task789", payload)
@@ -132,7 +136,8 @@ func TestUpdateTaskBuildsCorrectPath(t *testing.T) {
 	defer server.Close()
 	client := NewClient("key", server.URL, "")
 
-	payload := map[string]any{"name": "Updated"}
+	name := "Updated"
+	payload := UpdateTaskRequest{Name: &name}
 	UpdateTask(client, "task123", payload)
 
 	if capturedMethod != "PUT" {
@@ -142,3 +147,103 @@ func TestUpdateTaskBuildsCorrectPath(t *testing.T) {
 		t.Errorf("expected path '/task/task123', got '%s'", capturedPath)
 	}
 }
+
+func TestUpdateTaskSendsOnlySetFields(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"id": "task123"}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	status := "done"
+	points := 3.5
+	_, err := UpdateTask(client, "task123", UpdateTaskRequest{Status: &status, Points: &points})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(body) != 2 || body["status"] != "done" || body["points"] != 3.5 {
+		t.Errorf("expected only status and points, got %v", body)
+	}
+}
+
+func TestUpdateTaskClearsZeroedFields(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"id": "task123"}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	priority, dueDate := 0, int64(0)
+	_, err := UpdateTask(client, "task123", UpdateTaskRequest{Priority: &priority, DueDate: &dueDate})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, key := range []string{"priority", "due_date"} {
+		if v, ok := body[key]; !ok || v != nil {
+			t.Errorf("expected %s to be null, got %v", key, body)
+		}
+	}
+}
+
+func TestUpdateTaskRequestIsEmpty(t *testing.T) {
+	if !(UpdateTaskRequest{}).IsEmpty() {
+		t.Error("expected zero request to be empty")
+	}
+	name := "Task"
+	if (UpdateTaskRequest{Name: &name}).IsEmpty() {
+		t.Error("expected request with a name to not be empty")
+	}
+}
+
+func TestAddAndRemoveTaskTag(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.EscapedPath())
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	if err := AddTaskTag(client, "task123", "needs review"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := RemoveTaskTag(client, "task123", "bug"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"POST /task/task123/tag/needs%20review", "DELETE /task/task123/tag/bug"}
+	if len(calls) != 2 || calls[0] != expected[0] || calls[1] != expected[1] {
+		t.Errorf("expected %v, got %v", expected, calls)
+	}
+}
+
+func TestSetCustomField(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/task/task123/field/field-1" {
+			t.Errorf("expected path /task/task123/field/field-1, got %s", r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := NewClient("key", server.URL, "")
+
+	err := SetCustomField(client, "task123", CustomFieldValue{ID: "field-1", Value: 42})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["value"] != float64(42) {
+		t.Errorf("expected value 42, got %v", body["value"])
+	}
+}
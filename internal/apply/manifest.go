@@ -0,0 +1,121 @@
+// Package apply creates and updates folders, lists, and tasks to match a
+// YAML manifest.
+//
+// Folders and lists are matched by name. Tasks are matched within their
+// list by a key stored as a tag, such as "key:release-checklist", so they
+// can be renamed in the manifest without being created again. Only the
+// task fields set in the manifest are managed; anything else is left as
+// it is, and nothing is ever deleted.
+package apply
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// DefaultKeyTagPrefix prefixes task keys to make their tags.
+const DefaultKeyTagPrefix = "key:"
+
+// Manifest declares folders, lists, and tasks. Space is a space ID and
+// defaults to the configured space.
+type Manifest struct {
+	Space        string   `yaml:"space"`
+	KeyTagPrefix string   `yaml:"key_tag_prefix"`
+	Folders      []Folder `yaml:"folders"`
+}
+
+type Folder struct {
+	Name  string `yaml:"name"`
+	Lists []List `yaml:"lists"`
+}
+
+type List struct {
+	Name  string `yaml:"name"`
+	Tasks []Task `yaml:"tasks"`
+}
+
+// Task is a task and its subtasks. Fields left empty aren't managed.
+// Assignees, when given, are the complete set; tags are only added.
+type Task struct {
+	Key         string   `yaml:"key"`
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Status      string   `yaml:"status"`
+	Priority    string   `yaml:"priority"`
+	Due         string   `yaml:"due"`
+	Assignees   []string `yaml:"assignees"`
+	Tags        []string `yaml:"tags"`
+	Subtasks    []Task   `yaml:"subtasks"`
+}
+
+// Load reads and validates a manifest.
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	d := yaml.NewDecoder(bytes.NewReader(data))
+	d.KnownFields(true)
+	if err := d.Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Validate checks that everything is named and that task keys are unique.
+func (m *Manifest) Validate() error {
+	if m.KeyTagPrefix == "" {
+		m.KeyTagPrefix = DefaultKeyTagPrefix
+	}
+
+	keys := make(map[string]bool)
+	var checkTasks func(path string, tasks []Task) error
+	checkTasks = func(path string, tasks []Task) error {
+		for _, task := range tasks {
+			if task.Key == "" {
+				return fmt.Errorf("%s: task %q has no key", path, task.Name)
+			}
+			if task.Name == "" {
+				return fmt.Errorf("%s: task %s has no name", path, task.Key)
+			}
+			key := strings.ToLower(task.Key)
+			if keys[key] {
+				return fmt.Errorf("%s: duplicate task key %s", path, task.Key)
+			}
+			keys[key] = true
+			if err := checkTasks(path, task.Subtasks); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, folder := range m.Folders {
+		if folder.Name == "" {
+			return fmt.Errorf("folder has no name")
+		}
+		for _, list := range folder.Lists {
+			if list.Name == "" {
+				return fmt.Errorf("%s: list has no name", folder.Name)
+			}
+			if err := checkTasks(folder.Name+"/"+list.Name, list.Tasks); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// keyTag is the tag identifying a task. ClickUp stores tags in lowercase.
+func (m *Manifest) keyTag(key string) string {
+	return strings.ToLower(m.KeyTagPrefix + key)
+}
@@ -0,0 +1,94 @@
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeManifest(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "manifest.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeManifest(t, `
+space: "123"
+folders:
+  - name: Launch
+    lists:
+      - name: Backlog
+        tasks:
+          - key: release
+            name: Release
+            priority: high
+            subtasks:
+              - key: notes
+                name: Write notes
+`)
+
+	m, err := Load(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Space != "123" || m.KeyTagPrefix != DefaultKeyTagPrefix {
+		t.Errorf("expected space and default key prefix, got %+v", m)
+	}
+	task := m.Folders[0].Lists[0].Tasks[0]
+	if task.Priority != "high" || task.Subtasks[0].Key != "notes" {
+		t.Errorf("unexpected task %+v", task)
+	}
+	if m.keyTag("Release") != "key:release" {
+		t.Errorf("expected lowercase key tag, got %q", m.keyTag("Release"))
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := map[string]struct {
+		manifest string
+		err      string
+	}{
+		"unknown field": {
+			manifest: "folders:\n  - name: A\n    colour: red\n",
+			err:      "colour",
+		},
+		"unnamed list": {
+			manifest: "folders:\n  - name: A\n    lists:\n      - tasks: []\n",
+			err:      "list has no name",
+		},
+		"missing key": {
+			manifest: "folders:\n  - name: A\n    lists:\n      - name: B\n        tasks:\n          - name: T\n",
+			err:      "has no key",
+		},
+		"duplicate key": {
+			manifest: `
+folders:
+  - name: A
+    lists:
+      - name: B
+        tasks:
+          - key: t
+            name: One
+            subtasks:
+              - key: T
+                name: Two
+`,
+			err: "duplicate task key",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Load(writeManifest(t, tt.manifest))
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
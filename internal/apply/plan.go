@@ -0,0 +1,391 @@
+package apply
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+// Change actions.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+)
+
+// Change is a folder, list, or task the plan creates or updates.
+type Change struct {
+	Action string
+	Kind   string
+	Path   string
+	// Fields lists the fields an update changes.
+	Fields []string
+
+	apply func() error
+}
+
+func (c *Change) String() string {
+	if c.Action == ActionCreate {
+		return fmt.Sprintf("+ %s %s", c.Kind, c.Path)
+	}
+	return fmt.Sprintf("~ %s %s (%s)", c.Kind, c.Path, strings.Join(c.Fields, ", "))
+}
+
+// Plan is the changes that make ClickUp match a manifest, in the order
+// they must be made.
+type Plan struct {
+	Changes   []*Change
+	Unchanged int
+}
+
+// Print writes the changes and a summary line.
+func (p *Plan) Print(w io.Writer) {
+	creates := 0
+	for _, change := range p.Changes {
+		fmt.Fprintln(w, change)
+		if change.Action == ActionCreate {
+			creates++
+		}
+	}
+	fmt.Fprintf(w, "Plan: %d to create, %d to update, %d unchanged.\n", creates, len(p.Changes)-creates, p.Unchanged)
+}
+
+// Apply makes the changes in order, logging each one. It stops at the
+// first failure, since later changes may depend on it; applying the
+// manifest again picks up where it stopped.
+func (p *Plan) Apply(log io.Writer) error {
+	for _, change := range p.Changes {
+		if err := change.apply(); err != nil {
+			return fmt.Errorf("failed to %s %s %s: %w", change.Action, change.Kind, change.Path, err)
+		}
+		fmt.Fprintln(log, change)
+	}
+	return nil
+}
+
+// Planner compares a manifest with what exists in a space.
+type Planner struct {
+	Client *api.Client
+	// ResolveUser returns the ID of a user given by name, ID, or username.
+	ResolveUser func(string) (string, error)
+	// ParseDate parses a due date, returning milliseconds and whether the
+	// date has a time of day.
+	ParseDate func(string) (int64, bool, error)
+}
+
+// ref holds the ID of a folder, list, or task, which is only known once
+// a planned create has been applied.
+type ref struct {
+	id string
+}
+
+// Plan works out the changes needed to make the space match m.
+func (p *Planner) Plan(m *Manifest, spaceID string) (*Plan, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{}
+	folders, err := api.GetFolders(p.Client, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, folder := range m.Folders {
+		folderRef := &ref{}
+		var lists []api.List
+		if i := slices.IndexFunc(folders, func(f api.Folder) bool { return f.Name == folder.Name }); i >= 0 {
+			folderRef.id = folders[i].ID
+			lists, err = api.GetLists(p.Client, folderRef.id)
+			if err != nil {
+				return nil, err
+			}
+			plan.Unchanged++
+		} else {
+			name := folder.Name
+			plan.Changes = append(plan.Changes, &Change{
+				Action: ActionCreate,
+				Kind:   "folder",
+				Path:   name,
+				apply: func() error {
+					created, err := api.CreateFolder(p.Client, spaceID, api.CreateFolderRequest{Name: name})
+					folderRef.id = created.ID
+					return err
+				},
+			})
+		}
+
+		for _, list := range folder.Lists {
+			path := folder.Name + "/" + list.Name
+			listRef := &ref{}
+			existing := make(map[string]api.Task)
+			if i := slices.IndexFunc(lists, func(l api.List) bool { return l.Name == list.Name }); i >= 0 {
+				listRef.id = lists[i].ID
+				existing, err = p.keyedTasks(m, listRef.id)
+				if err != nil {
+					return nil, err
+				}
+				plan.Unchanged++
+			} else {
+				name := list.Name
+				plan.Changes = append(plan.Changes, &Change{
+					Action: ActionCreate,
+					Kind:   "list",
+					Path:   path,
+					apply: func() error {
+						created, err := api.CreateList(p.Client, folderRef.id, api.CreateListRequest{Name: name})
+						listRef.id = created.ID
+						return err
+					},
+				})
+			}
+
+			if err := p.planTasks(plan, m, path, listRef, nil, list.Tasks, existing); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return plan, nil
+}
+
+// keyedTasks returns a list's tasks, including subtasks and closed tasks,
+// by key tag.
+func (p *Planner) keyedTasks(m *Manifest, listID string) (map[string]api.Task, error) {
+	tasks := make(map[string]api.Task)
+	opts := api.TaskListOptions{Subtasks: true, IncludeClosed: true}
+	err := api.EachTaskPage(p.Client, listID, opts, func(page []api.Task) error {
+		for _, task := range page {
+			for _, tag := range task.Tags {
+				if strings.HasPrefix(tag.Name, strings.ToLower(m.KeyTagPrefix)) {
+					tasks[tag.Name] = task
+				}
+			}
+		}
+		return nil
+	})
+	return tasks, err
+}
+
+// desiredTask is a manifest task with its values converted for the API.
+// Unset fields stay zero.
+type desiredTask struct {
+	Task
+	priority    *int
+	due         *int64
+	dueTime     bool
+	assigneeIDs []string
+}
+
+func (p *Planner) desired(task Task) (desiredTask, error) {
+	d := desiredTask{Task: task}
+	if task.Priority != "" {
+		priority, err := api.ParsePriority(task.Priority)
+		if err != nil {
+			return d, err
+		}
+		d.priority = &priority
+	}
+	if task.Due != "" {
+		due, hasTime, err := p.ParseDate(task.Due)
+		if err != nil {
+			return d, err
+		}
+		d.due, d.dueTime = &due, hasTime
+	}
+	for _, name := range task.Assignees {
+		id, err := p.ResolveUser(name)
+		if err != nil {
+			return d, fmt.Errorf("failed to resolve assignee %q: %w", name, err)
+		}
+		d.assigneeIDs = append(d.assigneeIDs, id)
+	}
+	return d, nil
+}
+
+func (p *Planner) planTasks(plan *Plan, m *Manifest, listPath string, listRef, parentRef *ref, tasks []Task, existing map[string]api.Task) error {
+	for _, task := range tasks {
+		path := listPath + "/" + task.Key
+		d, err := p.desired(task)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if d.Status != "" && listRef.id != "" {
+			if d.Status, err = p.status(listRef.id, d.Status); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+
+		taskRef := &ref{}
+		keyTag := m.keyTag(task.Key)
+		if current, ok := existing[keyTag]; ok {
+			if parentRef == nil && current.ParentID != "" {
+				// ClickUp can move a subtask under another task, but not
+				// make it a top-level task.
+				return fmt.Errorf("%s: is a subtask of %s in ClickUp, which can't be made a top-level task; nest it in the manifest or move it by hand", path, current.ParentID)
+			}
+			taskRef.id = current.ID
+			change := p.planUpdate(d, current, parentRef)
+			if change != nil {
+				change.Path = path
+				plan.Changes = append(plan.Changes, change)
+			} else {
+				plan.Unchanged++
+			}
+		} else {
+			plan.Changes = append(plan.Changes, &Change{
+				Action: ActionCreate,
+				Kind:   "task",
+				Path:   path,
+				apply: func() error {
+					return p.create(d, keyTag, listRef, parentRef, taskRef)
+				},
+			})
+		}
+
+		if err := p.planTasks(plan, m, listPath, listRef, taskRef, task.Subtasks, existing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Planner) create(d desiredTask, keyTag string, listRef, parentRef, taskRef *ref) error {
+	req := api.CreateTaskRequest{
+		ListID:      listRef.id,
+		Name:        d.Name,
+		Description: d.Description,
+		Assignees:   d.assigneeIDs,
+		Tags:        append(slices.Clone(d.Tags), keyTag),
+	}
+	if parentRef != nil {
+		req.Parent = parentRef.id
+	}
+	if d.priority != nil {
+		req.Priority = *d.priority
+	}
+	if d.due != nil {
+		req.DueDate, req.DueDateTime = *d.due, d.dueTime
+	}
+	if d.Status != "" {
+		status, err := p.status(listRef.id, d.Status)
+		if err != nil {
+			return err
+		}
+		req.Status = status
+	}
+
+	created, err := api.CreateTask(p.Client, req)
+	taskRef.id = created.ID
+	return err
+}
+
+// planUpdate compares a task with the manifest, returning nil when they
+// already match.
+func (p *Planner) planUpdate(d desiredTask, current api.Task, parentRef *ref) *Change {
+	var (
+		req    api.UpdateTaskRequest
+		fields []string
+		tags   []string
+	)
+
+	if current.Name != d.Name {
+		req.Name = &d.Name
+		fields = append(fields, "name")
+	}
+	if d.Description != "" && strings.TrimSpace(current.Description) != strings.TrimSpace(d.Description) {
+		req.Description = &d.Description
+		fields = append(fields, "description")
+	}
+	if d.Status != "" && (current.Status == nil || !strings.EqualFold(current.Status.Status, d.Status)) {
+		req.Status = &d.Status
+		fields = append(fields, "status")
+	}
+	if d.priority != nil {
+		currentPriority := api.PriorityNone
+		if current.Priority != nil {
+			currentPriority = current.Priority.ID
+		}
+		if currentPriority != *d.priority {
+			req.Priority = d.priority
+			fields = append(fields, "priority")
+		}
+	}
+	if d.due != nil && current.DueDate != strconv.FormatInt(*d.due, 10) {
+		req.DueDate, req.DueDateTime = d.due, &d.dueTime
+		fields = append(fields, "due")
+	}
+	if d.Assignees != nil {
+		var currentIDs []string
+		for _, user := range current.Assignees {
+			currentIDs = append(currentIDs, user.ID)
+		}
+		update := &api.AssigneesUpdate{Add: []string{}, Rem: []string{}}
+		for _, id := range d.assigneeIDs {
+			if !slices.Contains(currentIDs, id) {
+				update.Add = append(update.Add, id)
+			}
+		}
+		for _, id := range currentIDs {
+			if !slices.Contains(d.assigneeIDs, id) {
+				update.Rem = append(update.Rem, id)
+			}
+		}
+		if len(update.Add) > 0 || len(update.Rem) > 0 {
+			req.Assignees = update
+			fields = append(fields, "assignees")
+		}
+	}
+	for _, tag := range d.Tags {
+		if !slices.ContainsFunc(current.Tags, func(t api.Tag) bool { return strings.EqualFold(t.Name, tag) }) {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) > 0 {
+		fields = append(fields, "tags")
+	}
+	parentChanged := parentRef != nil && (parentRef.id == "" || parentRef.id != current.ParentID)
+	if parentChanged {
+		fields = append(fields, "parent")
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return &Change{
+		Action: ActionUpdate,
+		Kind:   "task",
+		Fields: fields,
+		apply: func() error {
+			for _, tag := range tags {
+				if err := api.AddTaskTag(p.Client, current.ID, tag); err != nil {
+					return err
+				}
+			}
+			if parentChanged {
+				// A new parent's ID is only known once it's created.
+				req.Parent = &parentRef.id
+			}
+			if req.IsEmpty() {
+				return nil
+			}
+			_, err := api.UpdateTask(p.Client, current.ID, req)
+			return err
+		},
+	}
+}
+
+// status returns the exact name of a status of a list.
+func (p *Planner) status(listID, input string) (string, error) {
+	statuses, err := api.GetListStatuses(p.Client, listID)
+	if err != nil {
+		return "", err
+	}
+	status, err := api.MatchStatus(statuses, input)
+	if err != nil {
+		return "", err
+	}
+	return status.Status, nil
+}
@@ -0,0 +1,241 @@
+package apply
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+// testServer serves a space with a "Launch" folder holding a "Backlog"
+// list, and records every request that isn't a GET.
+type testServer struct {
+	mu        sync.Mutex
+	tasks     string
+	mutations []string
+	created   int
+}
+
+func (s *testServer) start(t *testing.T) *api.Client {
+	t.Helper()
+	statuses := `{"statuses": [{"status": "to do", "orderindex": 0}, {"status": "in progress", "orderindex": 1}]}`
+	responses := map[string]string{
+		"GET /space/s1/folder":  `{"folders": [{"id": "f1", "name": "Launch"}]}`,
+		"GET /folder/f1/list":   `{"lists": [{"id": "l1", "name": "Backlog"}]}`,
+		"GET /list/l1":          statuses,
+		"GET /list/l9":          statuses,
+		"POST /space/s1/folder": `{"id": "f9"}`,
+		"POST /folder/f9/list":  `{"id": "l9"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		key := r.Method + " " + r.URL.Path
+		if r.Method != http.MethodGet {
+			body, _ := io.ReadAll(r.Body)
+			s.mutations = append(s.mutations, strings.TrimSpace(key+" "+string(body)))
+		}
+		switch {
+		case key == "GET /list/l1/task":
+			w.Write([]byte(s.tasks))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/task"):
+			s.created++
+			fmt.Fprintf(w, `{"id": "new%d"}`, s.created)
+		case r.Method == http.MethodPut || strings.Contains(r.URL.Path, "/tag/"):
+			w.Write([]byte(`{}`))
+		default:
+			response, ok := responses[key]
+			if !ok {
+				t.Errorf("unexpected request %s", key)
+			}
+			w.Write([]byte(response))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return api.NewClient("key", server.URL, "")
+}
+
+func testPlanner(client *api.Client) *Planner {
+	return &Planner{
+		Client:      client,
+		ResolveUser: func(name string) (string, error) { return "u-" + name, nil },
+		ParseDate:   func(string) (int64, bool, error) { return 1000, false, nil },
+	}
+}
+
+const testManifest = `
+folders:
+  - name: Launch
+    lists:
+      - name: Backlog
+        tasks:
+          - key: release
+            name: Release
+            status: In Progress
+            priority: high
+            tags: [launch]
+            subtasks:
+              - key: notes
+                name: Write notes
+          - key: same
+            name: Same
+            priority: normal
+  - name: New
+    lists:
+      - name: Todo
+        tasks:
+          - key: first
+            name: First
+            status: in progress
+            assignees: [ana]
+`
+
+const testTasks = `{"last_page": true, "tasks": [
+	{"id": "t1", "name": "Release", "status": {"status": "to do"}, "priority": {"id": 3}, "tags": [{"name": "key:release"}, {"name": "launch"}]},
+	{"id": "t2", "name": "Same", "status": {"status": "to do"}, "priority": {"id": 3}, "tags": [{"name": "key:same"}]},
+	{"id": "t3", "name": "Unmanaged", "status": {"status": "to do"}}
+]}`
+
+func TestPlan(t *testing.T) {
+	s := &testServer{tasks: testTasks}
+	m, err := Load(writeManifest(t, testManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := testPlanner(s.start(t)).Plan(m, "s1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var out bytes.Buffer
+	plan.Print(&out)
+	expected := `~ task Launch/Backlog/release (status, priority)
++ task Launch/Backlog/notes
++ folder New
++ list New/Todo
++ task New/Todo/first
+Plan: 4 to create, 1 to update, 3 unchanged.
+`
+	if out.String() != expected {
+		t.Errorf("expected plan:\n%s\ngot:\n%s", expected, out.String())
+	}
+	if len(s.mutations) != 0 {
+		t.Errorf("expected planning to change nothing, got %v", s.mutations)
+	}
+}
+
+func TestPlanApply(t *testing.T) {
+	s := &testServer{tasks: testTasks}
+	m, err := Load(writeManifest(t, testManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := testPlanner(s.start(t)).Plan(m, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := plan.Apply(io.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		`PUT /task/t1 {"priority":2,"status":"in progress"}`,
+		`POST /list/l1/task {"name":"Write notes","tags":["key:notes"],"parent":"t1"}`,
+		`POST /space/s1/folder {"name":"New"}`,
+		`POST /folder/f9/list {"name":"Todo"}`,
+		`POST /list/l9/task {"name":"First","assignees":["u-ana"],"tags":["key:first"],"status":"in progress"}`,
+	}
+	if strings.Join(s.mutations, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected requests:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(s.mutations, "\n"))
+	}
+}
+
+func TestPlanUpdatesParentOfNewParent(t *testing.T) {
+	s := &testServer{tasks: `{"last_page": true, "tasks": [
+		{"id": "t5", "name": "Child", "tags": [{"name": "key:child"}]}
+	]}`}
+	m, err := Load(writeManifest(t, `
+folders:
+  - name: Launch
+    lists:
+      - name: Backlog
+        tasks:
+          - key: parent
+            name: Parent
+            subtasks:
+              - key: child
+                name: Child
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := testPlanner(s.start(t)).Plan(m, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := plan.Apply(io.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	last := s.mutations[len(s.mutations)-1]
+	if last != `PUT /task/t5 {"parent":"new1"}` {
+		t.Errorf("expected the child moved under the created parent, got %s", last)
+	}
+}
+
+func TestPlanRejectsSubtaskMadeTopLevel(t *testing.T) {
+	s := &testServer{tasks: `{"last_page": true, "tasks": [
+		{"id": "t5", "name": "Child", "parent": "t4", "tags": [{"name": "key:child"}]}
+	]}`}
+	m, err := Load(writeManifest(t, `
+folders:
+  - name: Launch
+    lists:
+      - name: Backlog
+        tasks:
+          - key: child
+            name: Child
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = testPlanner(s.start(t)).Plan(m, "s1")
+
+	if err == nil || !strings.Contains(err.Error(), "Launch/Backlog/child") || !strings.Contains(err.Error(), "t4") {
+		t.Errorf("expected an error naming the task and its parent, got %v", err)
+	}
+}
+
+func TestPlanUnknownStatus(t *testing.T) {
+	s := &testServer{tasks: testTasks}
+	m, err := Load(writeManifest(t, `
+folders:
+  - name: Launch
+    lists:
+      - name: Backlog
+        tasks:
+          - key: release
+            name: Release
+            status: shipped
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = testPlanner(s.start(t)).Plan(m, "s1")
+
+	if err == nil || !strings.Contains(err.Error(), "Launch/Backlog/release") {
+		t.Errorf("expected status error naming the task, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/apply"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply -f <manifest.yaml>",
+	Short: "Create and update folders, lists, and tasks to match a manifest",
+	Long: `Compare a YAML manifest of folders, lists, and tasks with a space, show
+the plan, and make the changes. The space is given by --space, then by ID
+in the manifest, then by the config.
+
+Folders and lists are matched by name. Each task has a key, stored on the
+task as a tag such as "key:release-checklist", which matches it on later
+runs even if it's renamed. Only the task fields set in the manifest are
+managed, and nothing is ever deleted.
+
+With --dry-run, only the plan is shown.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, _ := cmd.Flags().GetString("file")
+		manifest, err := apply.Load(path)
+		if err != nil {
+			return err
+		}
+
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		space := cmp.Or(spaceID, manifest.Space, cfg.SpaceID)
+		if space == "" {
+			return fmt.Errorf("no space to apply to: pass --space or set space in the manifest")
+		}
+
+		planner := &apply.Planner{
+			Client:      client,
+			ResolveUser: res.ResolveUser,
+			ParseDate:   parseDateArg,
+		}
+		plan, err := planner.Plan(manifest, space)
+		if err != nil {
+			return err
+		}
+
+		out := cmd.OutOrStdout()
+		plan.Print(out)
+		if len(plan.Changes) == 0 || dryRun {
+			return nil
+		}
+
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+			if !confirm(cmd, fmt.Sprintf("Apply %d changes?", len(plan.Changes))) {
+				return fmt.Errorf("aborted")
+			}
+		}
+		return plan.Apply(out)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+	applyCmd.Flags().StringP("file", "f", "", "manifest to apply")
+	applyCmd.MarkFlagRequired("file")
+	applyCmd.Flags().BoolP("yes", "y", false, "don't ask for confirmation")
+}
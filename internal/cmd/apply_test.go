@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// applyResponse answers every request: the space is empty, and anything
+// created gets the ID x1.
+const applyResponse = `{"id": "x1", "folders": [], "lists": [], "tasks": [], "last_page": true}`
+
+func writeApplyManifest(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "plan.yaml")
+	manifest := `
+space: s1
+folders:
+  - name: Launch
+    lists:
+      - name: Backlog
+        tasks:
+          - key: release
+            name: Release
+`
+	if err := os.WriteFile(path, []byte(manifest), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestApplyCreates(t *testing.T) {
+	var stdout bytes.Buffer
+	applyCmd.SetOut(&stdout)
+	t.Cleanup(func() { applyCmd.SetOut(nil) })
+
+	requests, err := runWithServerResponse(t, applyCmd, applyResponse, []string{"-f", writeApplyManifest(t), "--yes"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var paths []string
+	for _, req := range mutations(requests) {
+		paths = append(paths, req.Method+" "+req.Path)
+	}
+	expected := "POST /space/s1/folder,POST /folder/x1/list,POST /list/x1/task"
+	if strings.Join(paths, ",") != expected {
+		t.Errorf("expected %s, got %v", expected, paths)
+	}
+	if !strings.Contains(stdout.String(), "Plan: 3 to create, 0 to update, 0 unchanged.") {
+		t.Errorf("expected the plan, got %q", stdout.String())
+	}
+}
+
+func TestApplySpaceFlagOverridesManifest(t *testing.T) {
+	applyCmd.SetOut(&bytes.Buffer{})
+	t.Cleanup(func() { applyCmd.SetOut(nil) })
+
+	requests, err := runWithServerResponse(t, applyCmd, applyResponse, []string{"-f", writeApplyManifest(t), "--space", "s2", "--yes"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changes := mutations(requests)
+	if len(changes) == 0 || changes[0].Path != "/space/s2/folder" {
+		t.Errorf("expected the folder created in space s2, got %v", changes)
+	}
+}
+
+func TestApplyDeclined(t *testing.T) {
+	applyCmd.SetIn(strings.NewReader("n\n"))
+	applyCmd.SetOut(&bytes.Buffer{})
+	applyCmd.SetErr(&bytes.Buffer{})
+	t.Cleanup(func() {
+		applyCmd.SetIn(nil)
+		applyCmd.SetOut(nil)
+		applyCmd.SetErr(nil)
+	})
+
+	requests, err := runWithServerResponse(t, applyCmd, applyResponse, []string{"-f", writeApplyManifest(t)})
+	if err == nil || err.Error() != "aborted" {
+		t.Errorf("expected 'aborted' error, got %v", err)
+	}
+	if changes := mutations(requests); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestApplyDryRunOnlyPlans(t *testing.T) {
+	useDryRun(t)
+	applyCmd.SetOut(&bytes.Buffer{})
+	t.Cleanup(func() { applyCmd.SetOut(nil) })
+
+	requests, err := runWithServerResponse(t, applyCmd, applyResponse, []string{"-f", writeApplyManifest(t)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changes := mutations(requests); len(changes) != 0 {
+		t.Errorf("expected no changes in dry-run mode, got %v", changes)
+	}
+}
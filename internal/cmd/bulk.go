@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+// bulkConcurrency bounds the requests in flight when a command runs on
+// many tasks, to stay clear of the API's rate limit.
+const bulkConcurrency = 4
+
+// addBulkFlags adds the flags that select more than one task.
+func addBulkFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("stdin", false, "read task IDs, names, or URLs from stdin, one per line")
+	cmd.Flags().StringP("list", "l", "", "select the tasks of this list (name, ID, or URL)")
+	cmd.Flags().String("where-status", "", "with --list, only select tasks with this status")
+}
+
+// isBulk reports whether a command was asked to run on anything other than
+// a single task argument.
+func isBulk(cmd *cobra.Command, args []string) bool {
+	stdin, _ := cmd.Flags().GetBool("stdin")
+	list, _ := cmd.Flags().GetString("list")
+	return len(args) != 1 || stdin || list != ""
+}
+
+// taskTargets collects the tasks a bulk command runs on: the arguments,
+// the lines of stdin with --stdin, and the tasks of --list matching
+// --where-status. Names are resolved later, per task.
+func taskTargets(cmd *cobra.Command, client *api.Client, res *resolver.Resolver, args []string) ([]string, error) {
+	targets := append([]string{}, args...)
+
+	if stdin, _ := cmd.Flags().GetBool("stdin"); stdin {
+		scanner := bufio.NewScanner(cmd.InOrStdin())
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" && !strings.HasPrefix(line, "#") {
+				targets = append(targets, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+	}
+
+	listArg, _ := cmd.Flags().GetString("list")
+	whereStatus, _ := cmd.Flags().GetString("where-status")
+	if whereStatus != "" && listArg == "" {
+		return nil, fmt.Errorf("--where-status requires --list")
+	}
+	if listArg != "" {
+		listID, err := res.ResolveList(listArg)
+		if err != nil {
+			return nil, err
+		}
+		opts := api.TaskListOptions{Subtasks: true}
+		err = api.EachTaskPage(client, listID, opts, func(tasks []api.Task) error {
+			for _, task := range tasks {
+				if whereStatus == "" || (task.Status != nil && strings.EqualFold(task.Status.Status, whereStatus)) {
+					targets = append(targets, task.ID)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no tasks selected: pass task IDs, --stdin, or --list")
+	}
+	return targets, nil
+}
+
+// bulkResult is the outcome of a bulk command for one target.
+type bulkResult struct {
+	target string
+	taskID string
+	err    error
+}
+
+// runBulk resolves each target and runs fn on it, bulkConcurrency at a
+// time, showing progress on stderr. It prints a line per task once all
+// are done and fails if any task failed.
+func runBulk(cmd *cobra.Command, res *resolver.Resolver, targets []string, done string, fn func(taskID string) error) error {
+	results := make([]bulkResult, len(targets))
+	stderr := cmd.ErrOrStderr()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		finished int
+	)
+	sem := make(chan struct{}, bulkConcurrency)
+	for i, target := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := bulkResult{target: target}
+			result.taskID, result.err = res.ResolveTask(target)
+			if result.err == nil {
+				result.err = fn(result.taskID)
+			}
+			results[i] = result
+
+			mu.Lock()
+			finished++
+			fmt.Fprintf(stderr, "\r%d/%d tasks", finished, len(targets))
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	fmt.Fprintln(stderr)
+
+	out := cmd.OutOrStdout()
+	failed := 0
+	for _, result := range results {
+		if result.err != nil {
+			failed++
+			fmt.Fprintf(out, "Task %s failed: %v\n", result.target, result.err)
+			continue
+		}
+		printResult(out, "Task %s %s\n", result.taskID, done)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d tasks failed", failed, len(targets))
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/config"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/keyring"
+)
+
+// mutationPaths lists the paths of the non-GET requests, sorted, since bulk
+// commands send them concurrently.
+func mutationPaths(requests []capturedRequest, method string) []string {
+	var paths []string
+	for _, req := range mutations(requests) {
+		if req.Method == method {
+			paths = append(paths, req.Path)
+		}
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+func TestTasksDeleteMany(t *testing.T) {
+	requests, err := runWithServer(t, tasksDeleteCmd, []string{"--yes", "task1", "task2", "task3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := mutationPaths(requests, http.MethodDelete)
+	want := []string{"/task/task1", "/task/task2", "/task/task3"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected deletes %v, got %v", want, got)
+	}
+}
+
+func TestTasksArchiveWhereStatus(t *testing.T) {
+	tasks := `{"last_page": true, "tasks": [
+		{"id": "task1", "status": {"status": "done"}},
+		{"id": "task2", "status": {"status": "to do"}},
+		{"id": "task3", "status": {"status": "Done"}}
+	]}`
+	requests, err := runWithServerResponse(t, tasksArchiveCmd, tasks, []string{"--yes", "--list", "list456", "--where-status", "done"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := mutationPaths(requests, http.MethodPut)
+	want := []string{"/task/task1/archive", "/task/task3/archive"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected archives %v, got %v", want, got)
+	}
+}
+
+func TestTasksWhereStatusRequiresList(t *testing.T) {
+	_, err := runWithServer(t, tasksArchiveCmd, []string{"--where-status", "done"})
+	if err == nil || !strings.Contains(err.Error(), "--list") {
+		t.Errorf("expected error requiring --list, got %v", err)
+	}
+}
+
+func TestTasksUpdateStdin(t *testing.T) {
+	tasksUpdateCmd.SetIn(strings.NewReader("task1\n# skipped\n\ntask2\n"))
+	t.Cleanup(func() { tasksUpdateCmd.SetIn(nil) })
+
+	requests, err := runWithServer(t, tasksUpdateCmd, []string{"--stdin", "--title", "Renamed"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := mutationPaths(requests, http.MethodPut)
+	want := []string{"/task/task1", "/task/task2"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected updates %v, got %v", want, got)
+	}
+	for _, req := range mutations(requests) {
+		if req.Body["name"] != "Renamed" {
+			t.Errorf("expected name 'Renamed', got %v", req.Body["name"])
+		}
+	}
+}
+
+func TestTasksUpdateStdinDescriptionConflict(t *testing.T) {
+	_, err := runWithServer(t, tasksUpdateCmd, []string{"--stdin", "--description", "-"})
+	if err == nil {
+		t.Error("expected error reading both task IDs and description from stdin")
+	}
+}
+
+func TestTasksDeleteManyReportsFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/task/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"err": "Task not found", "ECODE": "ITEM_013"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	cfg = &config.Config{BaseURL: server.URL}
+	kr = keyring.New(&mockKeyringProvider{apiKey: "test-key"})
+
+	var stdout, stderr bytes.Buffer
+	tasksDeleteCmd.SetOut(&stdout)
+	tasksDeleteCmd.SetErr(&stderr)
+	t.Cleanup(func() {
+		tasksDeleteCmd.SetOut(nil)
+		tasksDeleteCmd.SetErr(nil)
+	})
+
+	tasksDeleteCmd.Flags().Set("yes", "true")
+	t.Cleanup(func() { resetFlags(tasksDeleteCmd) })
+
+	err := tasksDeleteCmd.RunE(tasksDeleteCmd, []string{"task1", "missing"})
+
+	if err == nil || err.Error() != "1 of 2 tasks failed" {
+		t.Errorf("expected '1 of 2 tasks failed', got %v", err)
+	}
+	if !strings.Contains(stdout.String(), "Task task1 deleted") || !strings.Contains(stdout.String(), "Task missing failed") {
+		t.Errorf("expected a line per task, got %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "2/2 tasks") {
+		t.Errorf("expected progress on stderr, got %q", stderr.String())
+	}
+}
+
+func TestTasksUnarchiveMany(t *testing.T) {
+	requests, err := runWithServer(t, tasksUnarchiveCmd, []string{"task1", "task2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := mutationPaths(requests, http.MethodPut)
+	want := []string{"/task/task1", "/task/task2"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected unarchives %v, got %v", want, got)
+	}
+	for _, req := range mutations(requests) {
+		if req.Body["archived"] != false {
+			t.Errorf("expected archived false, got %v", req.Body)
+		}
+	}
+}
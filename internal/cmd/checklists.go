@@ -0,0 +1,387 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+var checklistsCmd = &cobra.Command{
+	Use:   "checklists",
+	Short: "Manage task checklists",
+	Long: `Manage the checklists of a task and their items. Checklists and items
+are given by ID or by name, ignoring case.`,
+}
+
+var checklistsListCmd = &cobra.Command{
+	Use:   "list <task-id|name|url>",
+	Short: "List a task's checklists and their items",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, _, task, err := checklistTask(args[0])
+		if err != nil {
+			return err
+		}
+		return PrintOutput(buildChecklistsView(task.Checklists))
+	},
+}
+
+var checklistsAddCmd = &cobra.Command{
+	Use:   "add <task-id|name|url> <name>",
+	Short: "Add a checklist to a task",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, _, task, err := checklistTask(args[0])
+		if err != nil {
+			return err
+		}
+
+		checklist, err := api.CreateChecklist(client, task.ID, api.ChecklistRequest{Name: args[1]})
+		if err != nil {
+			return err
+		}
+
+		printResult(cmd.OutOrStdout(), "Checklist %s added to task %s\n", checklist.ID, task.ID)
+		return nil
+	},
+}
+
+var checklistsRenameCmd = &cobra.Command{
+	Use:   "rename <task-id|name|url> <checklist> <new-name>",
+	Short: "Rename a checklist",
+	Args:  cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, _, task, err := checklistTask(args[0])
+		if err != nil {
+			return err
+		}
+		checklist, err := findChecklist(task, args[1])
+		if err != nil {
+			return err
+		}
+
+		err = api.UpdateChecklist(client, checklist.ID, api.ChecklistRequest{Name: args[2]})
+		if err != nil {
+			return err
+		}
+
+		printResult(cmd.OutOrStdout(), "Checklist %s renamed\n", checklist.ID)
+		return nil
+	},
+}
+
+var checklistsDeleteCmd = &cobra.Command{
+	Use:   "delete <task-id|name|url> <checklist>",
+	Short: "Delete a checklist and its items",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, _, task, err := checklistTask(args[0])
+		if err != nil {
+			return err
+		}
+		checklist, err := findChecklist(task, args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := api.DeleteChecklist(client, checklist.ID); err != nil {
+			return err
+		}
+
+		printResult(cmd.OutOrStdout(), "Checklist %s deleted\n", checklist.ID)
+		return nil
+	},
+}
+
+var checklistItemCmd = &cobra.Command{
+	Use:   "item",
+	Short: "Manage checklist items",
+	Long: `Manage checklist items. Items are looked up in all of the task's
+checklists unless --checklist narrows the search.`,
+}
+
+var checklistItemAddCmd = &cobra.Command{
+	Use:   "add <task-id|name|url> <checklist> <name>",
+	Short: "Add an item to a checklist",
+	Args:  cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, res, task, err := checklistTask(args[0])
+		if err != nil {
+			return err
+		}
+		checklist, err := findChecklist(task, args[1])
+		if err != nil {
+			return err
+		}
+
+		req := api.ChecklistItemRequest{Name: args[2]}
+		if assignee, _ := cmd.Flags().GetString("assignee"); assignee != "" {
+			req.Assignee, err = res.ResolveUser(assignee)
+			if err != nil {
+				return fmt.Errorf("failed to resolve assignee: %w", err)
+			}
+		}
+
+		updated, err := api.CreateChecklistItem(client, checklist.ID, req)
+		if err != nil {
+			return err
+		}
+
+		// The new item is the one the checklist didn't have before.
+		for _, item := range updated.Items {
+			if !slices.ContainsFunc(checklist.Items, func(i api.ChecklistItem) bool { return i.ID == item.ID }) {
+				printResult(cmd.OutOrStdout(), "Item %s added to checklist %s\n", item.ID, checklist.ID)
+				return nil
+			}
+		}
+		printResult(cmd.OutOrStdout(), "Item added to checklist %s\n", checklist.ID)
+		return nil
+	},
+}
+
+var checklistItemCheckCmd = &cobra.Command{
+	Use:   "check <task-id|name|url> <item>",
+	Short: "Mark a checklist item as done",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return resolveChecklistItem(cmd, args, true)
+	},
+}
+
+var checklistItemUncheckCmd = &cobra.Command{
+	Use:   "uncheck <task-id|name|url> <item>",
+	Short: "Mark a checklist item as not done",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return resolveChecklistItem(cmd, args, false)
+	},
+}
+
+var checklistItemRemoveCmd = &cobra.Command{
+	Use:   "remove <task-id|name|url> <item>",
+	Short: "Remove an item from its checklist",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, _, task, err := checklistTask(args[0])
+		if err != nil {
+			return err
+		}
+		checklistArg, _ := cmd.Flags().GetString("checklist")
+		checklist, item, err := findChecklistItem(task, checklistArg, args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := api.DeleteChecklistItem(client, checklist.ID, item.ID); err != nil {
+			return err
+		}
+
+		printResult(cmd.OutOrStdout(), "Item %s removed\n", item.ID)
+		return nil
+	},
+}
+
+var checklistItemAssignCmd = &cobra.Command{
+	Use:   "assign <task-id|name|url> <item> <user>",
+	Short: "Assign a checklist item to a user",
+	Args:  cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, res, task, err := checklistTask(args[0])
+		if err != nil {
+			return err
+		}
+		checklistArg, _ := cmd.Flags().GetString("checklist")
+		checklist, item, err := findChecklistItem(task, checklistArg, args[1])
+		if err != nil {
+			return err
+		}
+
+		userID, err := res.ResolveUser(args[2])
+		if err != nil {
+			return fmt.Errorf("failed to resolve assignee: %w", err)
+		}
+
+		err = api.UpdateChecklistItem(client, checklist.ID, item.ID, api.UpdateChecklistItemRequest{Assignee: &userID})
+		if err != nil {
+			return err
+		}
+
+		printResult(cmd.OutOrStdout(), "Item %s assigned to %s\n", item.ID, args[2])
+		return nil
+	},
+}
+
+// resolveChecklistItem checks or unchecks the item given by args.
+func resolveChecklistItem(cmd *cobra.Command, args []string, resolved bool) error {
+	client, _, task, err := checklistTask(args[0])
+	if err != nil {
+		return err
+	}
+	checklistArg, _ := cmd.Flags().GetString("checklist")
+	checklist, item, err := findChecklistItem(task, checklistArg, args[1])
+	if err != nil {
+		return err
+	}
+
+	err = api.UpdateChecklistItem(client, checklist.ID, item.ID, api.UpdateChecklistItemRequest{Resolved: &resolved})
+	if err != nil {
+		return err
+	}
+
+	if resolved {
+		printResult(cmd.OutOrStdout(), "Item %s checked\n", item.ID)
+	} else {
+		printResult(cmd.OutOrStdout(), "Item %s unchecked\n", item.ID)
+	}
+	return nil
+}
+
+// checklistTask resolves and fetches a task, whose checklists come with it.
+func checklistTask(input string) (*api.Client, *resolver.Resolver, api.Task, error) {
+	kr := GetKeyring()
+	apiKey, err := kr.GetAPIKey()
+	if err != nil {
+		return nil, nil, api.Task{}, err
+	}
+
+	cfg := GetConfig()
+	client := newClient(apiKey, cfg)
+	res := resolver.New(client, cfg.StrictResolve)
+
+	taskID, err := res.ResolveTask(input)
+	if err != nil {
+		return nil, nil, api.Task{}, err
+	}
+	task, err := api.GetTask(client, taskID)
+	return client, res, task, err
+}
+
+// findChecklist finds a task's checklist by ID, or by name ignoring case.
+func findChecklist(task api.Task, input string) (api.Checklist, error) {
+	var matches []api.Checklist
+	for _, checklist := range task.Checklists {
+		if checklist.ID == input {
+			return checklist, nil
+		}
+		if strings.EqualFold(checklist.Name, input) {
+			matches = append(matches, checklist)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return api.Checklist{}, fmt.Errorf("no checklist %q on task %s", input, task.ID)
+	case 1:
+		return matches[0], nil
+	}
+	return api.Checklist{}, fmt.Errorf("%d checklists named %q on task %s, use the checklist ID", len(matches), input, task.ID)
+}
+
+// findChecklistItem finds an item by ID, or by name ignoring case, in the
+// checklist named by checklistArg or, when it's empty, in any of the task's
+// checklists.
+func findChecklistItem(task api.Task, checklistArg, input string) (api.Checklist, api.ChecklistItem, error) {
+	checklists := task.Checklists
+	if checklistArg != "" {
+		checklist, err := findChecklist(task, checklistArg)
+		if err != nil {
+			return api.Checklist{}, api.ChecklistItem{}, err
+		}
+		checklists = []api.Checklist{checklist}
+	}
+
+	type match struct {
+		checklist api.Checklist
+		item      api.ChecklistItem
+	}
+	var matches []match
+	for _, checklist := range checklists {
+		for _, item := range checklist.Items {
+			if item.ID == input {
+				return checklist, item, nil
+			}
+			if strings.EqualFold(item.Name, input) {
+				matches = append(matches, match{checklist, item})
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return api.Checklist{}, api.ChecklistItem{}, fmt.Errorf("no checklist item %q on task %s", input, task.ID)
+	case 1:
+		return matches[0].checklist, matches[0].item, nil
+	}
+	return api.Checklist{}, api.ChecklistItem{}, fmt.Errorf("%d checklist items named %q on task %s, use --checklist or the item ID", len(matches), input, task.ID)
+}
+
+type checklistView struct {
+	ID       string
+	Name     string
+	Done     string
+	Assignee string
+
+	level int
+}
+
+// IndentLevel nests items under their checklist in text and table output.
+func (v checklistView) IndentLevel() int {
+	return v.level
+}
+
+// buildChecklistsView lists each checklist with its completion count,
+// followed by its items.
+func buildChecklistsView(checklists []api.Checklist) []checklistView {
+	views := []checklistView{}
+	for _, checklist := range checklists {
+		views = append(views, checklistView{
+			ID:   checklist.ID,
+			Name: checklist.Name,
+			Done: checklistProgress(checklist),
+		})
+		for _, item := range checklist.Items {
+			view := checklistView{ID: item.ID, Name: item.Name, Done: "[ ]", level: 1}
+			if item.Resolved {
+				view.Done = "[x]"
+			}
+			if item.Assignee != nil {
+				view.Assignee = item.Assignee.Username
+			}
+			views = append(views, view)
+		}
+	}
+	return views
+}
+
+// checklistProgress counts the resolved items of a checklist, like "2/3".
+func checklistProgress(checklist api.Checklist) string {
+	resolved := 0
+	for _, item := range checklist.Items {
+		if item.Resolved {
+			resolved++
+		}
+	}
+	return fmt.Sprintf("%d/%d", resolved, len(checklist.Items))
+}
+
+func init() {
+	rootCmd.AddCommand(checklistsCmd)
+	checklistsCmd.AddCommand(checklistsListCmd)
+	checklistsCmd.AddCommand(checklistsAddCmd)
+	checklistsCmd.AddCommand(checklistsRenameCmd)
+	checklistsCmd.AddCommand(checklistsDeleteCmd)
+	checklistsCmd.AddCommand(checklistItemCmd)
+	checklistItemCmd.AddCommand(checklistItemAddCmd)
+	checklistItemCmd.AddCommand(checklistItemCheckCmd)
+	checklistItemCmd.AddCommand(checklistItemUncheckCmd)
+	checklistItemCmd.AddCommand(checklistItemRemoveCmd)
+	checklistItemCmd.AddCommand(checklistItemAssignCmd)
+	checklistItemAddCmd.Flags().StringP("assignee", "a", "", "assign the item to a user (name, ID, or username)")
+	for _, cmd := range []*cobra.Command{checklistItemCheckCmd, checklistItemUncheckCmd, checklistItemRemoveCmd, checklistItemAssignCmd} {
+		cmd.Flags().String("checklist", "", "checklist to look for the item in (name or ID)")
+	}
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/config"
+)
+
+// checklistTaskResponse is a task with two checklists that both have an
+// item named "Docs".
+const checklistTaskResponse = `{"id": "task123", "name": "Task", "checklists": [
+	{"id": "cl1", "name": "Definition of done", "items": [
+		{"id": "i1", "name": "Tests pass", "resolved": true},
+		{"id": "i2", "name": "Docs", "resolved": false, "assignee": {"id": "7", "username": "alice"}}
+	]},
+	{"id": "cl2", "name": "Release", "items": [
+		{"id": "i3", "name": "Docs", "resolved": false}
+	]}
+]}`
+
+func checklistTestTask() api.Task {
+	return api.Task{
+		ID: "task123",
+		Checklists: []api.Checklist{
+			{ID: "cl1", Name: "Definition of done", Items: []api.ChecklistItem{
+				{ID: "i1", Name: "Tests pass", Resolved: true},
+				{ID: "i2", Name: "Docs", Assignee: &api.User{Username: "alice"}},
+			}},
+			{ID: "cl2", Name: "Release", Items: []api.ChecklistItem{
+				{ID: "i3", Name: "Docs"},
+			}},
+		},
+	}
+}
+
+func TestFindChecklistItem(t *testing.T) {
+	task := checklistTestTask()
+
+	tests := []struct {
+		checklist, item string
+		expected        string
+		err             string
+	}{
+		{item: "tests PASS", expected: "i1"},
+		{item: "i3", expected: "i3"},
+		{checklist: "release", item: "Docs", expected: "i3"},
+		{item: "Docs", err: "use --checklist"},
+		{item: "Missing", err: "no checklist item"},
+		{checklist: "Missing", item: "Docs", err: "no checklist"},
+	}
+
+	for _, tt := range tests {
+		checklist, item, err := findChecklistItem(task, tt.checklist, tt.item)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%q in %q: expected error containing %q, got %v", tt.item, tt.checklist, tt.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q in %q: unexpected error: %v", tt.item, tt.checklist, err)
+			continue
+		}
+		inChecklist := slices.ContainsFunc(checklist.Items, func(i api.ChecklistItem) bool { return i.ID == item.ID })
+		if item.ID != tt.expected || !inChecklist {
+			t.Errorf("%q in %q: expected %s, got %s in %s", tt.item, tt.checklist, tt.expected, item.ID, checklist.ID)
+		}
+	}
+}
+
+func TestBuildChecklistsView(t *testing.T) {
+	views := buildChecklistsView(checklistTestTask().Checklists)
+
+	if len(views) != 5 {
+		t.Fatalf("expected 2 checklists and 3 items, got %d views", len(views))
+	}
+	if views[0].Name != "Definition of done" || views[0].Done != "1/2" || views[0].IndentLevel() != 0 {
+		t.Errorf("expected the checklist with its progress, got %+v", views[0])
+	}
+	if views[1].Done != "[x]" || views[2].Done != "[ ]" || views[2].Assignee != "alice" || views[2].IndentLevel() != 1 {
+		t.Errorf("expected nested items with their state, got %+v %+v", views[1], views[2])
+	}
+}
+
+func TestChecklistItemCheck(t *testing.T) {
+	checklistItemCheckCmd.SetOut(&bytes.Buffer{})
+	t.Cleanup(func() { checklistItemCheckCmd.SetOut(nil) })
+
+	requests, err := runWithServerResponse(t, checklistItemCheckCmd, checklistTaskResponse,
+		[]string{"task123", "docs", "--checklist", "Release"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changes := mutations(requests)
+	if len(changes) != 1 || changes[0].Method != http.MethodPut || changes[0].Path != "/checklist/cl2/checklist_item/i3" {
+		t.Fatalf("expected a PUT of item i3, got %v", changes)
+	}
+	if changes[0].Body["resolved"] != true {
+		t.Errorf("expected resolved true, got %v", changes[0].Body)
+	}
+}
+
+func TestChecklistItemCheckDryRunPrintsNoResult(t *testing.T) {
+	useDryRun(t)
+	var out bytes.Buffer
+	checklistItemCheckCmd.SetOut(&out)
+	t.Cleanup(func() { checklistItemCheckCmd.SetOut(nil) })
+
+	requests, err := runWithServerResponse(t, checklistItemCheckCmd, checklistTaskResponse,
+		[]string{"task123", "docs", "--checklist", "Release"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if changes := mutations(requests); len(changes) != 0 {
+		t.Errorf("expected no changes sent, got %v", changes)
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected no result under dry-run, got %q", out.String())
+	}
+}
+
+func TestChecklistsRename(t *testing.T) {
+	checklistsRenameCmd.SetOut(&bytes.Buffer{})
+	t.Cleanup(func() { checklistsRenameCmd.SetOut(nil) })
+
+	requests, err := runWithServerResponse(t, checklistsRenameCmd, checklistTaskResponse,
+		[]string{"task123", "definition of done", "DoD"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changes := mutations(requests)
+	if len(changes) != 1 || changes[0].Path != "/checklist/cl1" || changes[0].Body["name"] != "DoD" {
+		t.Errorf("expected checklist cl1 renamed, got %v", changes)
+	}
+}
+
+func TestFormatTaskDetailsViewWithChecklists(t *testing.T) {
+	cfg = &config.Config{OutputFormat: "text"}
+	useFormatter(t, "text")
+
+	formatted, err := formatTaskDetailsView(checklistTestTask())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, expected := range []string{"Definition of done (1/2)", "  [x] Tests pass", "  [ ] Docs · alice", "Release (0/1)"} {
+		if !strings.Contains(formatted, expected) {
+			t.Errorf("expected %q in details view, got:\n%s", expected, formatted)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+var tasksCloneCmd = &cobra.Command{
+	Use:   "clone <task-id|name|url>",
+	Short: "Copy a task, optionally with its subtasks and comments",
+	Long: `Create a copy of a task with the same description, priority, assignees,
+and tags. The copy goes in the same list unless --to-list is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		taskID, err := res.ResolveTask(args[0])
+		if err != nil {
+			return err
+		}
+
+		source, err := api.GetTask(client, taskID)
+		if err != nil {
+			return err
+		}
+
+		listID := source.ListID
+		if listArg, _ := cmd.Flags().GetString("to-list"); listArg != "" {
+			listID, err = res.ResolveList(listArg)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The copy keeps the source's parent only when it stays in the
+		// same list; a parent can't be in another list.
+		parent := ""
+		if listID == source.ListID {
+			parent = source.ParentID
+		}
+		if title, _ := cmd.Flags().GetString("title"); title != "" {
+			source.Name = title
+		}
+
+		c := taskCloner{client: client, listID: listID}
+		c.withSubtasks, _ = cmd.Flags().GetBool("with-subtasks")
+		c.withComments, _ = cmd.Flags().GetBool("with-comments")
+		if err := c.clone(source, parent, 0); err != nil {
+			if len(c.cloned) > 0 {
+				printChanged(c.cloned)
+			}
+			return err
+		}
+
+		return printChanged(c.cloned)
+	},
+}
+
+// clonedTaskView maps a source task to its copy.
+type clonedTaskView struct {
+	Source string
+	Clone  string
+	Name   string
+
+	level int
+}
+
+// IndentLevel nests cloned subtasks under their parent in text and table
+// output.
+func (v clonedTaskView) IndentLevel() int {
+	return v.level
+}
+
+// taskCloner recreates a task tree in a list, recording each copy made.
+type taskCloner struct {
+	client       *api.Client
+	listID       string
+	withSubtasks bool
+	withComments bool
+
+	cloned []clonedTaskView
+}
+
+func (c *taskCloner) clone(source api.Task, parent string, level int) error {
+	created, err := api.CreateTask(c.client, cloneTaskRequest(source, c.listID, parent))
+	if err != nil {
+		return fmt.Errorf("failed to clone task %s: %w", source.ID, err)
+	}
+	if dryRun {
+		// Nothing was created; stand in for the copy so that the requests
+		// for its subtasks and comments show where they would go.
+		created.ID = "(clone of " + source.ID + ")"
+	}
+	c.cloned = append(c.cloned, clonedTaskView{
+		Source: source.ID,
+		Clone:  created.ID,
+		Name:   created.Name,
+		level:  level,
+	})
+
+	if c.withComments {
+		if err := c.cloneComments(source.ID, created.ID); err != nil {
+			return err
+		}
+	}
+
+	if !c.withSubtasks {
+		return nil
+	}
+	for _, subtask := range source.Subtasks {
+		// Subtasks come back without descriptions or their own subtasks,
+		// so each one is fetched in full.
+		full, err := api.GetTask(c.client, subtask.ID)
+		if err != nil {
+			return err
+		}
+		if err := c.clone(full, created.ID, level+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cloneComments copies the comments of one task to another, oldest first.
+// The copies are authored by the current user.
+func (c *taskCloner) cloneComments(sourceID, cloneID string) error {
+	comments, err := api.GetAllTaskComments(c.client, sourceID)
+	if err != nil {
+		return err
+	}
+	for i := len(comments) - 1; i >= 0; i-- {
+		req := api.CommentRequest{CommentText: comments[i].TextContent}
+		if _, err := api.CreateTaskComment(c.client, cloneID, req); err != nil {
+			return fmt.Errorf("failed to copy comment %s: %w", comments[i].ID, err)
+		}
+	}
+	return nil
+}
+
+// cloneTaskRequest builds the request that recreates source in listID.
+// Status and dates are left for the destination list to default.
+func cloneTaskRequest(source api.Task, listID, parent string) api.CreateTaskRequest {
+	req := api.CreateTaskRequest{
+		ListID:              listID,
+		Name:                source.Name,
+		MarkdownDescription: source.MarkdownDescription,
+		Parent:              parent,
+	}
+	if req.MarkdownDescription == "" {
+		req.Description = source.Description
+	}
+	if source.Priority != nil {
+		req.Priority = source.Priority.ID
+	}
+	for _, user := range source.Assignees {
+		req.Assignees = append(req.Assignees, user.ID)
+	}
+	for _, tag := range source.Tags {
+		req.Tags = append(req.Tags, tag.Name)
+	}
+	return req
+}
+
+func init() {
+	tasksCmd.AddCommand(tasksCloneCmd)
+	tasksCloneCmd.Flags().String("to-list", "", "list to create the copy in (defaults to the source's list)")
+	tasksCloneCmd.Flags().String("title", "", "title of the copy (defaults to the source's title)")
+	tasksCloneCmd.Flags().Bool("with-subtasks", false, "also copy subtasks, recursively")
+	tasksCloneCmd.Flags().Bool("with-comments", false, "also copy comments")
+}
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/config"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/keyring"
+)
+
+func TestCloneTaskRequest(t *testing.T) {
+	var source api.Task
+	json.Unmarshal([]byte(`{
+		"id": "task1",
+		"name": "Release checklist",
+		"markdown_description": "# Steps",
+		"priority": {"id": 2, "priority": "high"},
+		"assignees": [{"id": "7"}, {"id": "8"}],
+		"tags": [{"name": "release"}]
+	}`), &source)
+
+	req := cloneTaskRequest(source, "list2", "parent1")
+
+	if req.ListID != "list2" || req.Parent != "parent1" || req.Name != "Release checklist" {
+		t.Errorf("unexpected request %+v", req)
+	}
+	if req.MarkdownDescription != "# Steps" || req.Description != "" {
+		t.Errorf("expected markdown description only, got %+v", req)
+	}
+	if req.Priority != api.PriorityHigh {
+		t.Errorf("expected priority %d, got %d", api.PriorityHigh, req.Priority)
+	}
+	if strings.Join(req.Assignees, ",") != "7,8" || strings.Join(req.Tags, ",") != "release" {
+		t.Errorf("unexpected assignees %v or tags %v", req.Assignees, req.Tags)
+	}
+}
+
+func TestTasksCloneWithSubtasks(t *testing.T) {
+	tasks := map[string]string{
+		"task1": `{"id": "task1", "name": "Release", "list": "list1", "subtasks": [{"id": "task2"}]}`,
+		"task2": `{"id": "task2", "name": "Tag", "list": "list1", "parent": "task1", "subtasks": [{"id": "task3"}]}`,
+		"task3": `{"id": "task3", "name": "Push", "list": "list1", "parent": "task2"}`,
+	}
+
+	var created []capturedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			req := capturedRequest{Method: r.Method, Path: r.URL.Path}
+			json.NewDecoder(r.Body).Decode(&req.Body)
+			created = append(created, req)
+			w.Write([]byte(`{"id": "new` + string(rune('0'+len(created))) + `"}`))
+			return
+		}
+		w.Write([]byte(tasks[strings.TrimPrefix(r.URL.Path, "/task/")]))
+	}))
+	defer server.Close()
+
+	cfg = &config.Config{BaseURL: server.URL}
+	kr = keyring.New(&mockKeyringProvider{apiKey: "test-key"})
+	useFormatter(t, "json")
+	t.Cleanup(func() { resetFlags(tasksCloneCmd) })
+
+	tasksCloneCmd.ParseFlags([]string{"--with-subtasks", "--title", "Release 2.0"})
+	if err := tasksCloneCmd.RunE(tasksCloneCmd, []string{"task1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(created) != 3 {
+		t.Fatalf("expected 3 tasks created, got %d", len(created))
+	}
+	if created[0].Body["name"] != "Release 2.0" || created[0].Body["parent"] != nil {
+		t.Errorf("unexpected root copy %v", created[0].Body)
+	}
+	if created[1].Body["parent"] != "new1" || created[2].Body["parent"] != "new2" {
+		t.Errorf("expected subtasks under their copied parents, got %v and %v", created[1].Body, created[2].Body)
+	}
+	for _, req := range created {
+		if req.Path != "/list/list1/task" {
+			t.Errorf("expected copies in list1, got %s", req.Path)
+		}
+	}
+}
+
+func TestTaskClonerDryRunStandsInForCopies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/comment") {
+			w.Write([]byte(`{"comments": [{"id": "c1", "text_content": "Ship it"}]}`))
+			return
+		}
+		w.Write([]byte(`{"id": "task2", "name": "Tag", "list": "list1", "parent": "task1"}`))
+	}))
+	defer server.Close()
+	client := api.NewClient("key", server.URL, "")
+	var out strings.Builder
+	client.SetDryRun(&out)
+	useDryRun(t)
+
+	c := taskCloner{client: client, listID: "list1", withSubtasks: true, withComments: true}
+	source := api.Task{ID: "task1", Name: "Release", Subtasks: []api.Task{{ID: "task2"}}}
+	if err := c.clone(source, "", 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(out.String(), `"parent":"(clone of task1)"`) {
+		t.Errorf("expected the subtask under a stand-in for its parent's copy, got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "POST "+server.URL+"/task/(clone of task1)/comment\n") {
+		t.Errorf("expected the comment posted to a stand-in for the copy, got:\n%s", out.String())
+	}
+}
+
+func TestTaskClonerCopiesEveryPageOfComments(t *testing.T) {
+	var posted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var req api.CommentRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			posted = append(posted, req.CommentText)
+			w.Write([]byte(`{"id": 1}`))
+			return
+		}
+		if r.URL.Query().Get("start_id") == "" {
+			var comments []string
+			for i := 26; i > 1; i-- {
+				comments = append(comments, fmt.Sprintf(`{"id": "c%d", "text_content": "%d", "date_created": "%d"}`, i, i, i))
+			}
+			fmt.Fprintf(w, `{"comments": [%s]}`, strings.Join(comments, ","))
+			return
+		}
+		w.Write([]byte(`{"comments": [{"id": "c1", "text_content": "1"}]}`))
+	}))
+	defer server.Close()
+
+	c := taskCloner{client: api.NewClient("key", server.URL, "")}
+	if err := c.cloneComments("task1", "task2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(posted) != 26 || posted[0] != "1" || posted[25] != "26" {
+		t.Errorf("expected all 26 comments copied oldest first, got %v", posted)
+	}
+}
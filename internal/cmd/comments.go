@@ -0,0 +1,286 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+var commentsCmd = &cobra.Command{
+	Use:   "comments",
+	Short: "Manage task comments",
+}
+
+var commentsListCmd = &cobra.Command{
+	Use:   "list <task-id|name|url>",
+	Short: "List comments on a task",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		taskID, err := res.ResolveTask(args[0])
+		if err != nil {
+			return err
+		}
+
+		comments, err := api.GetTaskComments(client, taskID)
+		if err != nil {
+			return err
+		}
+
+		return PrintOutput(buildCommentsView(comments))
+	},
+}
+
+var commentsAddCmd = &cobra.Command{
+	Use:   "add <task-id|name|url> <text>",
+	Short: "Add a comment to a task",
+	Long: `Add a comment to a task. Pass "-" as the text to read it from stdin, or
+@path to read it from a file; start the text with @@ for a literal @. Use
+--reply-to to post a threaded reply to an existing comment of the task
+instead.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		text, err := readCommentText(args[1], cmd)
+		if err != nil {
+			return err
+		}
+
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		taskID, err := res.ResolveTask(args[0])
+		if err != nil {
+			return err
+		}
+
+		req := api.CommentRequest{CommentText: text}
+		req.NotifyAll, _ = cmd.Flags().GetBool("notify-all")
+
+		assignee, _ := cmd.Flags().GetString("assignee")
+		if assignee != "" {
+			req.Assignee, err = res.ResolveUser(assignee)
+			if err != nil {
+				return fmt.Errorf("failed to resolve assignee: %w", err)
+			}
+		}
+
+		replyTo, _ := cmd.Flags().GetString("reply-to")
+		if replyTo != "" {
+			if _, err := api.GetTaskComment(client, taskID, replyTo); err != nil {
+				return err
+			}
+			created, err := api.ReplyToComment(client, replyTo, req)
+			if err != nil {
+				return err
+			}
+			printResult(cmd.OutOrStdout(), "Reply %s added to comment %s\n", created.ID, replyTo)
+			return nil
+		}
+
+		created, err := api.CreateTaskComment(client, taskID, req)
+		if err != nil {
+			return err
+		}
+
+		printResult(cmd.OutOrStdout(), "Comment %s added to task %s\n", created.ID, taskID)
+		return nil
+	},
+}
+
+var commentsEditCmd = &cobra.Command{
+	Use:   "edit <task-id|name|url> <comment-id> <text>",
+	Short: "Edit a comment",
+	Long: `Replace the text of a comment. Pass "-" as the text to read it from
+stdin, or @path to read it from a file; start the text with @@ for a
+literal @. The task is needed to look up the comment, so that its
+assignee and resolved state are kept.`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID := args[1]
+
+		text, err := readCommentText(args[2], cmd)
+		if err != nil {
+			return err
+		}
+
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		taskID, err := res.ResolveTask(args[0])
+		if err != nil {
+			return err
+		}
+
+		assignee, _ := cmd.Flags().GetString("assignee")
+		if assignee != "" {
+			assignee, err = res.ResolveUser(assignee)
+			if err != nil {
+				return fmt.Errorf("failed to resolve assignee: %w", err)
+			}
+		}
+
+		comment, err := api.GetTaskComment(client, taskID, commentID)
+		if err != nil {
+			return err
+		}
+
+		err = api.EditComment(client, comment, text, assignee)
+		if err != nil {
+			return err
+		}
+
+		printResult(cmd.OutOrStdout(), "Comment %s updated\n", commentID)
+		return nil
+	},
+}
+
+var commentsResolveCmd = &cobra.Command{
+	Use:   "resolve <task-id|name|url> <comment-id>",
+	Short: "Resolve an assigned comment",
+	Long: `Resolve an assigned comment of a task. The task is needed to look up
+the comment, since ClickUp requires its text to be sent back with the
+change.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID := args[1]
+
+		unresolve, _ := cmd.Flags().GetBool("unresolve")
+
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		taskID, err := res.ResolveTask(args[0])
+		if err != nil {
+			return err
+		}
+
+		comment, err := api.GetTaskComment(client, taskID, commentID)
+		if err != nil {
+			return err
+		}
+
+		err = api.ResolveComment(client, comment, !unresolve)
+		if err != nil {
+			return err
+		}
+
+		if unresolve {
+			printResult(cmd.OutOrStdout(), "Comment %s reopened\n", commentID)
+		} else {
+			printResult(cmd.OutOrStdout(), "Comment %s resolved\n", commentID)
+		}
+		return nil
+	},
+}
+
+var commentsDeleteCmd = &cobra.Command{
+	Use:   "delete <comment-id>",
+	Short: "Delete a comment",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		commentID := args[0]
+
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+
+		err = api.DeleteComment(client, commentID)
+		if err != nil {
+			return err
+		}
+
+		printResult(cmd.OutOrStdout(), "Comment %s deleted\n", commentID)
+		return nil
+	},
+}
+
+type commentView struct {
+	ID       string
+	Author   string
+	Assignee string
+	Resolved bool
+	Date     string
+	Content  string
+}
+
+func buildCommentsView(comments []api.Comment) []commentView {
+	views := []commentView{}
+	for _, comment := range comments {
+		view := commentView{
+			ID:       comment.ID,
+			Author:   comment.User.Username,
+			Resolved: comment.Resolved,
+			Date:     formatTimestamp(comment.DateCreated),
+			Content:  strings.TrimRight(comment.TextContent, "\n"),
+		}
+		if comment.Assignee != nil {
+			view.Assignee = comment.Assignee.Username
+		}
+		views = append(views, view)
+	}
+	return views
+}
+
+// readCommentText expands a comment text argument and rejects empty text.
+func readCommentText(value string, cmd *cobra.Command) (string, error) {
+	text, err := readTextArg(value, cmd.InOrStdin())
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(text) == "" {
+		return "", fmt.Errorf("comment text cannot be empty")
+	}
+	return text, nil
+}
+
+func init() {
+	rootCmd.AddCommand(commentsCmd)
+	commentsCmd.AddCommand(commentsListCmd)
+	commentsCmd.AddCommand(commentsAddCmd)
+	commentsCmd.AddCommand(commentsEditCmd)
+	commentsCmd.AddCommand(commentsResolveCmd)
+	commentsCmd.AddCommand(commentsDeleteCmd)
+	commentsAddCmd.Flags().StringP("assignee", "a", "", "assign the comment to a user (name, ID, or username)")
+	commentsAddCmd.Flags().Bool("notify-all", false, "notify everyone watching the task, including the author")
+	commentsAddCmd.Flags().String("reply-to", "", "comment ID to reply to in its thread")
+	commentsEditCmd.Flags().StringP("assignee", "a", "", "reassign the comment to a user (name, ID, or username)")
+	commentsResolveCmd.Flags().Bool("unresolve", false, "reopen a resolved comment")
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/config"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/keyring"
+)
+
+func TestCommentsCommand(t *testing.T) {
+	cmd := commentsCmd
+	if cmd.Use != "comments" {
+		t.Errorf("expected Use 'comments', got '%s'", cmd.Use)
+	}
+
+	expected := []string{"list", "add", "edit", "resolve", "delete"}
+	for _, name := range expected {
+		found := false
+		for _, sub := range cmd.Commands() {
+			if sub.Name() == name {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected comments subcommand %q", name)
+		}
+	}
+}
+
+func TestCommentsAddCmdFlags(t *testing.T) {
+	for _, name := range []string{"assignee", "notify-all", "reply-to"} {
+		if commentsAddCmd.Flags().Lookup(name) == nil {
+			t.Errorf("expected --%s flag", name)
+		}
+	}
+}
+
+func TestCommentsAddReadsStdin(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/task/abc123/comment" {
+			t.Errorf("expected path /task/abc123/comment, got %s", r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"id": 458}`))
+	}))
+	defer server.Close()
+
+	cfg = &config.Config{BaseURL: server.URL}
+	kr = keyring.New(&mockKeyringProvider{apiKey: "test-key"})
+
+	cmd := commentsAddCmd
+	var out bytes.Buffer
+	cmd.SetIn(strings.NewReader("Build failed:\n\n    exit status 1\n"))
+	cmd.SetOut(&out)
+	defer cmd.SetIn(nil)
+	defer cmd.SetOut(nil)
+
+	if err := cmd.RunE(cmd, []string{"abc123", "-"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["comment_text"] != "Build failed:\n\n    exit status 1" {
+		t.Errorf("unexpected comment_text %q", body["comment_text"])
+	}
+	if out.String() != "Comment 458 added to task abc123\n" {
+		t.Errorf("unexpected output %q", out.String())
+	}
+}
+
+func TestReadCommentTextRejectsEmpty(t *testing.T) {
+	cmd := commentsAddCmd
+	cmd.SetIn(strings.NewReader("\n\n"))
+	defer cmd.SetIn(nil)
+
+	if _, err := readCommentText("-", cmd); err == nil {
+		t.Error("expected error for empty comment text")
+	}
+}
+
+func TestBuildCommentsView(t *testing.T) {
+	comments := []api.Comment{
+		{
+			ID:          "458",
+			TextContent: "Please review\n",
+			User:        api.User{Username: "john"},
+			Assignee:    &api.User{Username: "jane"},
+			DateCreated: "1735689600000",
+		},
+	}
+
+	views := buildCommentsView(comments)
+
+	if len(views) != 1 {
+		t.Fatalf("expected 1 comment, got %d", len(views))
+	}
+	view := views[0]
+	if view.Author != "john" || view.Assignee != "jane" {
+		t.Errorf("unexpected author/assignee: %+v", view)
+	}
+	if view.Content != "Please review" {
+		t.Errorf("expected trimmed content, got %q", view.Content)
+	}
+	if view.Date != formatTimestamp("1735689600000") {
+		t.Errorf("expected formatted date, got %q", view.Date)
+	}
+}
+
+// taskCommentsResponse serves both the task and its comments.
+const taskCommentsResponse = `{"id": "task123", "name": "Task", "comments": [
+	{"id": "458", "text_content": "Ship it", "assignee": {"id": "7", "username": "jane"}}
+]}`
+
+func TestCommentsResolveSendsText(t *testing.T) {
+	commentsResolveCmd.SetOut(&bytes.Buffer{})
+	t.Cleanup(func() { commentsResolveCmd.SetOut(nil) })
+
+	requests, err := runWithServerResponse(t, commentsResolveCmd, taskCommentsResponse, []string{"task123", "458"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changes := mutations(requests)
+	if len(changes) != 1 || changes[0].Path != "/comment/458" {
+		t.Fatalf("expected a PUT of comment 458, got %v", changes)
+	}
+	if changes[0].Body["comment_text"] != "Ship it" || changes[0].Body["resolved"] != true {
+		t.Errorf("expected the comment's text with resolved true, got %v", changes[0].Body)
+	}
+}
+
+func TestCommentsEditKeepsAssigneeAndResolved(t *testing.T) {
+	commentsEditCmd.SetOut(&bytes.Buffer{})
+	t.Cleanup(func() { commentsEditCmd.SetOut(nil) })
+	response := `{"comments": [{"id": "458", "text_content": "Ship it", "resolved": true, "assignee": {"id": "7"}}]}`
+
+	requests, err := runWithServerResponse(t, commentsEditCmd, response, []string{"task123", "458", "Shipped"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changes := mutations(requests)
+	if len(changes) != 1 || changes[0].Path != "/comment/458" {
+		t.Fatalf("expected a PUT of comment 458, got %v", changes)
+	}
+	body := changes[0].Body
+	if body["comment_text"] != "Shipped" || body["resolved"] != true || body["assignee"] != "7" {
+		t.Errorf("expected the new text with the assignee and resolved state kept, got %v", body)
+	}
+}
+
+func TestCommentsAddReplyToChecksTask(t *testing.T) {
+	commentsAddCmd.SetOut(&bytes.Buffer{})
+	t.Cleanup(func() { commentsAddCmd.SetOut(nil) })
+
+	requests, err := runWithServerResponse(t, commentsAddCmd, taskCommentsResponse,
+		[]string{"task123", "Thanks", "--reply-to", "999"})
+
+	if err == nil || !strings.Contains(err.Error(), "not found on task") {
+		t.Errorf("expected an error for a comment of another task, got %v", err)
+	}
+	if changes := mutations(requests); len(changes) != 0 {
+		t.Errorf("expected no reply posted, got %v", changes)
+	}
+}
+
+func TestCommentsAddDryRunPrintsNoResult(t *testing.T) {
+	useDryRun(t)
+	var out bytes.Buffer
+	commentsAddCmd.SetOut(&out)
+	t.Cleanup(func() { commentsAddCmd.SetOut(nil) })
+
+	requests, err := runWithServer(t, commentsAddCmd, []string{"task123", "Looks good"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if changes := mutations(requests); len(changes) != 0 {
+		t.Errorf("expected no requests sent in dry-run mode, got %v", changes)
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected no result for a comment that wasn't added, got %q", out.String())
+	}
+}
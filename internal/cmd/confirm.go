@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+// confirmTasks shows the tasks the targets resolve to, with the names of
+// their lists, and asks on stdin whether to apply action to them. Names are
+// resolved first so that a loose name match is seen before anything
+// changes.
+func confirmTasks(cmd *cobra.Command, client *api.Client, res *resolver.Resolver, targets []string, action string) (bool, error) {
+	if stdin, _ := cmd.Flags().GetBool("stdin"); stdin {
+		return false, fmt.Errorf("can't confirm while reading tasks from stdin, pass --yes")
+	}
+
+	stderr := cmd.ErrOrStderr()
+	listNames := map[string]string{}
+	for _, target := range targets {
+		taskID, err := res.ResolveTask(target)
+		if err != nil {
+			return false, err
+		}
+		task, err := api.GetTask(client, taskID)
+		if err != nil {
+			return false, err
+		}
+		listName, ok := listNames[task.ListID]
+		if !ok {
+			list, err := api.GetList(client, task.ListID)
+			if err != nil {
+				return false, err
+			}
+			listName = list.Name
+			listNames[task.ListID] = listName
+		}
+		fmt.Fprintf(stderr, "  %s (%s) in list %s\n", task.Name, task.ID, listName)
+	}
+
+	noun := "task"
+	if len(targets) != 1 {
+		noun = "tasks"
+	}
+	return confirm(cmd, fmt.Sprintf("%s %d %s?", action, len(targets), noun)), nil
+}
+
+// confirm asks a yes/no question on stderr and reads the answer from
+// stdin. Anything but yes is no.
+func confirm(cmd *cobra.Command, question string) bool {
+	fmt.Fprintf(cmd.ErrOrStderr(), "%s [y/N] ", question)
+
+	answer, _ := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
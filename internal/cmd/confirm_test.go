@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTasksDeleteConfirmed(t *testing.T) {
+	var stderr bytes.Buffer
+	tasksDeleteCmd.SetIn(strings.NewReader("y\n"))
+	tasksDeleteCmd.SetErr(&stderr)
+	t.Cleanup(func() {
+		tasksDeleteCmd.SetIn(nil)
+		tasksDeleteCmd.SetErr(nil)
+	})
+
+	requests, err := runWithServer(t, tasksDeleteCmd, []string{"task123"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := mutationPaths(requests, http.MethodDelete); len(got) != 1 {
+		t.Errorf("expected the task to be deleted, got %v", got)
+	}
+	prompt := stderr.String()
+	if !strings.Contains(prompt, "Task (task123) in list Backlog") || !strings.Contains(prompt, "Delete 1 task? [y/N]") {
+		t.Errorf("expected the task in the prompt, got %q", prompt)
+	}
+}
+
+func TestTasksDeleteDeclined(t *testing.T) {
+	tasksDeleteCmd.SetIn(strings.NewReader("\n"))
+	tasksDeleteCmd.SetErr(&bytes.Buffer{})
+	t.Cleanup(func() {
+		tasksDeleteCmd.SetIn(nil)
+		tasksDeleteCmd.SetErr(nil)
+	})
+
+	requests, err := runWithServer(t, tasksDeleteCmd, []string{"task123"})
+	if err == nil || err.Error() != "aborted" {
+		t.Errorf("expected 'aborted' error, got %v", err)
+	}
+	if changes := mutations(requests); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestTasksArchiveStdinNeedsYes(t *testing.T) {
+	tasksArchiveCmd.SetIn(strings.NewReader("task1\ntask2\n"))
+	t.Cleanup(func() { tasksArchiveCmd.SetIn(nil) })
+
+	requests, err := runWithServer(t, tasksArchiveCmd, []string{"--stdin"})
+	if err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("expected error asking for --yes, got %v", err)
+	}
+	if changes := mutations(requests); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestTasksDeleteDryRunSkipsPrompt(t *testing.T) {
+	useDryRun(t)
+
+	requests, err := runWithServer(t, tasksDeleteCmd, []string{"task123"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changes := mutations(requests); len(changes) != 0 {
+		t.Errorf("expected no requests sent in dry-run mode, got %v", changes)
+	}
+}
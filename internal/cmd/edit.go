@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+)
+
+const frontMatterDelimiter = "---"
+
+// taskEditDocument holds the editable fields of a task. The description is
+// the markdown body below the front matter.
+type taskEditDocument struct {
+	Name        string `yaml:"name"`
+	Status      string `yaml:"status"`
+	Priority    string `yaml:"priority"`
+	Due         string `yaml:"due"`
+	Description string `yaml:"-"`
+}
+
+var tasksEditCmd = &cobra.Command{
+	Use:   "edit <task-id|name|url>",
+	Short: "Edit a task in $EDITOR",
+	Long: `Open the task's name, status, priority, due date, and description in
+$VISUAL or $EDITOR as a markdown file with YAML front matter. Only the
+fields changed in the editor are sent to ClickUp.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		taskArg := args[0]
+
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		taskID, err := res.ResolveTask(taskArg)
+		if err != nil {
+			return err
+		}
+
+		task, err := api.GetTask(client, taskID)
+		if err != nil {
+			return err
+		}
+
+		before := newTaskEditDocument(task)
+		original, err := before.render()
+		if err != nil {
+			return err
+		}
+
+		edited, err := editInEditor(original)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(edited, original) {
+			fmt.Fprintln(cmd.ErrOrStderr(), "No changes made")
+			return nil
+		}
+
+		after, err := parseTaskEditDocument(edited)
+		if err != nil {
+			return err
+		}
+
+		req, err := taskEditChanges(before, after)
+		if err != nil {
+			return err
+		}
+		if req.Status != nil {
+			status, err := resolveStatus(client, task.ListID, *req.Status)
+			if err != nil {
+				return err
+			}
+			req.Status = &status
+		}
+		if req.IsEmpty() {
+			fmt.Fprintln(cmd.ErrOrStderr(), "No changes made")
+			return nil
+		}
+
+		updated, err := api.UpdateTask(client, taskID, req)
+		if err != nil {
+			return err
+		}
+
+		return printTaskResult(updated)
+	},
+}
+
+func newTaskEditDocument(task api.Task) taskEditDocument {
+	doc := taskEditDocument{
+		Name:        task.Name,
+		Description: task.MarkdownDescription,
+	}
+	if doc.Description == "" {
+		doc.Description = task.Description
+	}
+	if task.Status != nil {
+		doc.Status = task.Status.Status
+	}
+	if task.Priority != nil {
+		doc.Priority = task.Priority.Priority
+	}
+	if task.DueDate != "" {
+		if ms, err := strconv.ParseInt(task.DueDate, 10, 64); err == nil {
+			due := time.UnixMilli(ms)
+			layout := dateTimeLayout
+			if due.Hour() == 0 && due.Minute() == 0 {
+				layout = dateLayout
+			}
+			doc.Due = due.Format(layout)
+		}
+	}
+	return doc
+}
+
+// render writes the document as YAML front matter followed by the
+// description.
+func (d taskEditDocument) render() ([]byte, error) {
+	header, err := yaml.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(frontMatterDelimiter + "\n")
+	buf.Write(header)
+	buf.WriteString(frontMatterDelimiter + "\n\n")
+	if d.Description != "" {
+		buf.WriteString(strings.TrimRight(d.Description, "\n") + "\n")
+	}
+	return buf.Bytes(), nil
+}
+
+func parseTaskEditDocument(content []byte) (taskEditDocument, error) {
+	var doc taskEditDocument
+
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	if !strings.HasPrefix(text, frontMatterDelimiter+"\n") {
+		return doc, fmt.Errorf("edited task is missing its front matter")
+	}
+	rest := strings.TrimPrefix(text, frontMatterDelimiter+"\n")
+	header, body, found := strings.Cut(rest, "\n"+frontMatterDelimiter+"\n")
+	if !found {
+		header, found = strings.CutSuffix(rest, "\n"+frontMatterDelimiter)
+		if !found {
+			return doc, fmt.Errorf("edited task front matter is not closed with %q", frontMatterDelimiter)
+		}
+	}
+
+	if err := yaml.Unmarshal([]byte(header), &doc); err != nil {
+		return doc, fmt.Errorf("failed to parse front matter: %w", err)
+	}
+	doc.Description = strings.TrimSpace(body)
+	return doc, nil
+}
+
+// taskEditChanges builds an update request holding only the fields that
+// differ between the fetched and the edited document.
+func taskEditChanges(before, after taskEditDocument) (api.UpdateTaskRequest, error) {
+	var req api.UpdateTaskRequest
+
+	if name := strings.TrimSpace(after.Name); name != strings.TrimSpace(before.Name) {
+		if name == "" {
+			return req, fmt.Errorf("task name cannot be empty")
+		}
+		req.Name = &name
+	}
+
+	if status := strings.TrimSpace(after.Status); !strings.EqualFold(status, before.Status) {
+		req.Status = &status
+	}
+
+	if priority := strings.ToLower(strings.TrimSpace(after.Priority)); priority != strings.ToLower(before.Priority) {
+		level, err := api.ParsePriority(priority)
+		if err != nil {
+			return req, err
+		}
+		req.Priority = &level
+	}
+
+	if due := strings.TrimSpace(after.Due); due != before.Due {
+		var dueDate int64
+		if due != "" {
+			var hasTime bool
+			var err error
+			dueDate, hasTime, err = parseDateArg(due)
+			if err != nil {
+				return req, err
+			}
+			req.DueDateTime = &hasTime
+		}
+		req.DueDate = &dueDate
+	}
+
+	if after.Description != strings.TrimSpace(before.Description) {
+		req.MarkdownDescription = &after.Description
+	}
+
+	return req, nil
+}
+
+// editInEditor writes content to a temporary markdown file, opens it in
+// the user's editor, and returns the saved content.
+func editInEditor(content []byte) ([]byte, error) {
+	file, err := os.CreateTemp("", "clickup-task-*.md")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+
+	editor := strings.Fields(editorCommand())
+	editorCmd := exec.Command(editor[0], append(editor[1:], file.Name())...)
+	editorCmd.Stdin = os.Stdin
+	editorCmd.Stdout = os.Stdout
+	editorCmd.Stderr = os.Stderr
+	if err := editorCmd.Run(); err != nil {
+		return nil, fmt.Errorf("editor %s failed: %w", editor[0], err)
+	}
+
+	return os.ReadFile(file.Name())
+}
+
+// editorCommand returns $VISUAL or $EDITOR, falling back to vi.
+func editorCommand() string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(name)); editor != "" {
+			return editor
+		}
+	}
+	return "vi"
+}
+
+func init() {
+	tasksCmd.AddCommand(tasksEditCmd)
+}
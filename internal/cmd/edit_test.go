@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTasksEditCmd(t *testing.T) {
+	cmd := tasksEditCmd
+	if cmd.Use != "edit <task-id|name|url>" {
+		t.Errorf("expected Use 'edit <task-id|name|url>', got '%s'", cmd.Use)
+	}
+	if cmd.Short == "" {
+		t.Error("expected non-empty Short description")
+	}
+}
+
+func TestTaskEditDocumentRoundTrip(t *testing.T) {
+	doc := taskEditDocument{
+		Name:        "Fix: login bug",
+		Status:      "in progress",
+		Priority:    "high",
+		Due:         "2025-01-15",
+		Description: "## Steps\n\n- open the app\n- log in",
+	}
+
+	content, err := doc.render()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(string(content), "---\nname: 'Fix: login bug'\n") {
+		t.Errorf("expected front matter with the quoted name, got:\n%s", content)
+	}
+
+	parsed, err := parseTaskEditDocument(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parsed != doc {
+		t.Errorf("expected %+v, got %+v", doc, parsed)
+	}
+}
+
+func TestParseTaskEditDocumentMissingFrontMatter(t *testing.T) {
+	if _, err := parseTaskEditDocument([]byte("just a description\n")); err == nil {
+		t.Error("expected error for a document without front matter")
+	}
+	if _, err := parseTaskEditDocument([]byte("---\nname: Task\n")); err == nil {
+		t.Error("expected error for unterminated front matter")
+	}
+}
+
+func TestTaskEditChangesOnlyChangedFields(t *testing.T) {
+	before := taskEditDocument{Name: "Task", Status: "open", Priority: "normal", Description: "Body"}
+	after := before
+	after.Priority = "Urgent"
+	after.Description = "New body"
+
+	req, err := taskEditChanges(before, after)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Name != nil || req.Status != nil || req.DueDate != nil {
+		t.Errorf("expected unchanged fields to be unset, got %+v", req)
+	}
+	if req.Priority == nil || *req.Priority != 1 {
+		t.Errorf("expected priority 1, got %v", req.Priority)
+	}
+	if req.MarkdownDescription == nil || *req.MarkdownDescription != "New body" {
+		t.Errorf("expected new description, got %v", req.MarkdownDescription)
+	}
+}
+
+func TestTaskEditChangesUnchanged(t *testing.T) {
+	doc := taskEditDocument{Name: "Task", Status: "open", Due: "2025-01-15"}
+
+	req, err := taskEditChanges(doc, doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !req.IsEmpty() {
+		t.Errorf("expected no changes, got %+v", req)
+	}
+}
+
+func TestTaskEditChangesDueDate(t *testing.T) {
+	before := taskEditDocument{Name: "Task", Due: "2025-01-15"}
+
+	after := before
+	after.Due = "2025-02-01"
+	req, err := taskEditChanges(before, after)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := time.Date(2025, 2, 1, 0, 0, 0, 0, time.Local).UnixMilli()
+	if req.DueDate == nil || *req.DueDate != expected {
+		t.Errorf("expected due date %d, got %v", expected, req.DueDate)
+	}
+
+	after.Due = ""
+	req, _ = taskEditChanges(before, after)
+	if req.DueDate == nil || *req.DueDate != 0 {
+		t.Errorf("expected cleared due date, got %+v", req)
+	}
+}
+
+func TestTaskEditChangesInvalid(t *testing.T) {
+	before := taskEditDocument{Name: "Task"}
+
+	for _, after := range []taskEditDocument{
+		{Name: ""},
+		{Name: "Task", Priority: "someday"},
+		{Name: "Task", Due: "next week"},
+	} {
+		if _, err := taskEditChanges(before, after); err == nil {
+			t.Errorf("expected error for %+v", after)
+		}
+	}
+}
+
+func TestEditorCommand(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "nano -w")
+	if got := editorCommand(); got != "nano -w" {
+		t.Errorf("expected $EDITOR, got %q", got)
+	}
+
+	t.Setenv("VISUAL", "code --wait")
+	if got := editorCommand(); got != "code --wait" {
+		t.Errorf("expected $VISUAL to take precedence, got %q", got)
+	}
+
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "")
+	if got := editorCommand(); got != "vi" {
+		t.Errorf("expected vi fallback, got %q", got)
+	}
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportCmdFlags(t *testing.T) {
+	for _, name := range []string{"folder", "list", "out", "incremental", "concurrency"} {
+		if exportCmd.Flags().Lookup(name) == nil {
+			t.Errorf("expected --%s flag", name)
+		}
+	}
+}
+
+func TestExportRequiresScope(t *testing.T) {
+	_, err := runWithServer(t, exportCmd, []string{"--out", t.TempDir()})
+	if err == nil || !strings.Contains(err.Error(), "nothing to export") {
+		t.Errorf("expected error asking for a scope, got %v", err)
+	}
+}
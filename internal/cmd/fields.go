@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/output"
+	"github.com/spf13/pflag"
+)
+
+// allFields selects the full api.Task in structured output and every
+// field in the others.
+const allFields = "all"
+
+type taskField struct {
+	column string
+	value  func(api.Task) any
+}
+
+var taskFieldNames = []string{
+	"id", "custom_id", "name", "title", "description", "status", "priority",
+	"assignee", "assignees", "due", "start", "created", "updated", "closed",
+	"tags", "url", "list", "parent",
+}
+
+var taskFields = map[string]taskField{
+	"id":          {"ID", func(t api.Task) any { return t.ID }},
+	"custom_id":   {"CustomID", func(t api.Task) any { return t.CustomID }},
+	"name":        {"Name", func(t api.Task) any { return t.Name }},
+	"title":       {"Title", func(t api.Task) any { return t.Name }},
+	"description": {"Description", func(t api.Task) any { return t.Description }},
+	"status":      {"Status", func(t api.Task) any { return taskStatus(t) }},
+	"priority":    {"Priority", func(t api.Task) any { return taskPriority(t) }},
+	"assignee": {"Assignee", func(t api.Task) any {
+		if t.Assignee == nil {
+			return ""
+		}
+		return t.Assignee.Username
+	}},
+	"assignees": {"Assignees", func(t api.Task) any {
+		names := []string{}
+		for _, u := range t.Assignees {
+			names = append(names, u.Username)
+		}
+		return names
+	}},
+	"due":     {"Due", func(t api.Task) any { return t.DueDate }},
+	"start":   {"Start", func(t api.Task) any { return t.StartDate }},
+	"created": {"Created", func(t api.Task) any { return t.DateCreated }},
+	"updated": {"Updated", func(t api.Task) any { return t.DateUpdated }},
+	"closed":  {"Closed", func(t api.Task) any { return t.DateClosed }},
+	"tags": {"Tags", func(t api.Task) any {
+		names := []string{}
+		for _, tag := range t.Tags {
+			names = append(names, tag.Name)
+		}
+		return names
+	}},
+	"url":    {"URL", func(t api.Task) any { return t.URL }},
+	"list":   {"List", func(t api.Task) any { return t.ListID }},
+	"parent": {"Parent", func(t api.Task) any { return t.ParentID }},
+}
+
+// everyField lists each field once for --fields all; "title" repeats
+// "name".
+func everyField() []string {
+	var fields []string
+	for _, name := range taskFieldNames {
+		if name != "title" {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// fieldsAlias accepts --columns as an alias for --fields.
+func fieldsAlias(f *pflag.FlagSet, name string) pflag.NormalizedName {
+	if name == "columns" {
+		name = "fields"
+	}
+	return pflag.NormalizedName(name)
+}
+
+// parseFields splits a --fields value into field names and validates them.
+// It returns nil when no fields were requested.
+func parseFields(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	var fields []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		if _, ok := taskFields[name]; !ok && name != allFields {
+			return nil, fmt.Errorf("unknown field %q (valid fields: %s, all)", name, strings.Join(taskFieldNames, ", "))
+		}
+		seen[name] = true
+		fields = append(fields, name)
+	}
+	if seen[allFields] && len(fields) > 1 {
+		return nil, fmt.Errorf("--fields all cannot be combined with other fields")
+	}
+	return fields, nil
+}
+
+// selectTaskFields builds one row per task containing only the requested
+// fields, in the requested order. Rows are structs built at runtime so every
+// output format walks them like any other view. Fields are keyed by their
+// field name in json and yaml output, and each row embeds its nesting level
+// and colors so subtasks stay indented and statuses colored in text and
+// table output.
+func selectTaskFields(tasks []nestedTask, fields []string) any {
+	structFields := make([]reflect.StructField, 0, len(fields)+1)
+	structFields = append(structFields, reflect.StructField{
+		Name:      "RowStyle",
+		Type:      reflect.TypeFor[output.RowStyle](),
+		Tag:       `json:"-"`,
+		Anonymous: true,
+	})
+	for _, name := range fields {
+		field := taskFields[name]
+		structFields = append(structFields, reflect.StructField{
+			Name: field.column,
+			Type: reflect.TypeOf(field.value(api.Task{})),
+			Tag:  reflect.StructTag(fmt.Sprintf(`json:"%s"`, name)),
+		})
+	}
+	rowType := reflect.StructOf(structFields)
+
+	now := time.Now()
+	rows := reflect.MakeSlice(reflect.SliceOf(rowType), 0, len(tasks))
+	for _, n := range tasks {
+		row := reflect.New(rowType).Elem()
+		row.Field(0).Set(reflect.ValueOf(output.RowStyle{Depth: n.level, Colors: taskColors(n.task, now)}))
+		for i, name := range fields {
+			row.Field(i + 1).Set(reflect.ValueOf(taskFields[name].value(n.task)))
+		}
+		rows = reflect.Append(rows, row)
+	}
+	return rows.Interface()
+}
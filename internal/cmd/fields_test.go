@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+func TestParseFields(t *testing.T) {
+	fields, err := parseFields(" ID, due ,tags,id")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(fields, ",") != "id,due,tags" {
+		t.Errorf("expected normalized, de-duplicated fields, got %v", fields)
+	}
+}
+
+func TestParseFieldsEmpty(t *testing.T) {
+	fields, err := parseFields("")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fields != nil {
+		t.Errorf("expected nil fields, got %v", fields)
+	}
+}
+
+func TestParseFieldsUnknown(t *testing.T) {
+	_, err := parseFields("id,bogus")
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "bogus") || !strings.Contains(err.Error(), "assignees") {
+		t.Errorf("error should name the field and list valid ones, got: %v", err)
+	}
+}
+
+func TestParseFieldsAllCannotBeCombined(t *testing.T) {
+	_, err := parseFields("all,id")
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestFormatTasksListViewWithFields(t *testing.T) {
+	tasks := []api.Task{
+		{
+			ID:      "task1",
+			Name:    "Test Task",
+			DueDate: "1735689600000",
+			Tags:    []api.Tag{{Name: "bug"}, {Name: "ui"}},
+			URL:     "https://app.clickup.com/t/task1",
+		},
+	}
+	useFormatter(t, "json")
+
+	formatted, err := formatTasksListView(tasks, "url", "id", "tags")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var rows []map[string]any
+	if err := json.Unmarshal([]byte(formatted), &rows); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(rows[0]) != 3 {
+		t.Errorf("expected only the selected fields, got %v", rows[0])
+	}
+	if rows[0]["url"] != "https://app.clickup.com/t/task1" || rows[0]["id"] != "task1" {
+		t.Errorf("expected fields keyed like the task JSON, got %v", rows[0])
+	}
+	if strings.Index(formatted, `"url"`) > strings.Index(formatted, `"id"`) {
+		t.Error("fields should appear in the requested order")
+	}
+}
+
+func TestFormatTasksListViewWithFieldsRendersStatusAsText(t *testing.T) {
+	tasks := []api.Task{{ID: "task1"}, {ID: "task2"}}
+	tasks[0].Status = &struct {
+		ID      string `json:"id"`
+		Status  string `json:"status"`
+		Color   string `json:"color"`
+		OrderBy int    `json:"orderby"`
+	}{Status: "open", Color: "#ff0000"}
+	useFormatter(t, "json")
+
+	formatted, err := formatTasksListView(tasks, "id", "status", "priority")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var rows []map[string]any
+	if err := json.Unmarshal([]byte(formatted), &rows); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if rows[0]["status"] != "open" || rows[1]["status"] != "" || rows[0]["priority"] != "" {
+		t.Errorf("expected status and priority as plain strings, got %v", rows)
+	}
+
+	useFormatter(t, "text")
+	formatter.SetColor(true)
+	formatted, err = formatTasksListView(tasks[:1], "status")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if formatted != "Status: \x1b[38;5;196mopen\x1b[0m" {
+		t.Errorf("expected the status in its color, got %q", formatted)
+	}
+}
+
+func TestFormatTasksListViewWithFieldsNestsSubtasks(t *testing.T) {
+	tasks := []api.Task{
+		{ID: "child", Name: "Child", ParentID: "parent"},
+		{ID: "parent", Name: "Parent"},
+	}
+	useFormatter(t, "text")
+
+	formatted, err := formatTasksListView(tasks, "id", "name")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "ID: parent | Name: Parent\n  ID: child | Name: Child"
+	if formatted != expected {
+		t.Errorf("expected %q, got %q", expected, formatted)
+	}
+}
+
+func TestFormatTasksListViewWithFieldsCSV(t *testing.T) {
+	tasks := []api.Task{
+		{ID: "task1", Tags: []api.Tag{{Name: "bug"}, {Name: "ui"}}},
+	}
+	useFormatter(t, "csv")
+
+	formatted, err := formatTasksListView(tasks, "id", "tags")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if formatted != "ID,Tags\ntask1,\"bug, ui\"" {
+		t.Errorf("unexpected CSV output: %q", formatted)
+	}
+}
+
+func TestFormatTasksListViewWithAllFields(t *testing.T) {
+	tasks := []api.Task{{ID: "task1", Name: "Test Task", URL: "https://app.clickup.com/t/task1"}}
+	useFormatter(t, "json")
+
+	formatted, err := formatTasksListView(tasks, allFields)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(formatted, `"url": "https://app.clickup.com/t/task1"`) {
+		t.Errorf("expected the full task, got: %s", formatted)
+	}
+}
+
+func TestFormatTasksListViewWithAllFieldsText(t *testing.T) {
+	tasks := []api.Task{
+		{ID: "child", Name: "Child", ParentID: "parent"},
+		{ID: "parent", Name: "Parent"},
+	}
+	useFormatter(t, "text")
+
+	formatted, err := formatTasksListView(tasks, allFields)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "ID: parent | Name: Parent\n  ID: child | Name: Child | Parent: parent"
+	if formatted != expected {
+		t.Errorf("expected every set field with the subtask nested, got %q", formatted)
+	}
+}
+
+func TestTasksListCmdColumnsAlias(t *testing.T) {
+	flag := tasksListCmd.Flags().Lookup("columns")
+
+	if flag == nil || flag.Name != "fields" {
+		t.Error("expected --columns to alias --fields")
+	}
+}
@@ -27,7 +27,7 @@ var foldersListCmd = &cobra.Command{
 			return err
 		}
 
-		client := api.NewClient(apiKey, cfg.BaseURL, cfg.SpaceID)
+		client := newClient(apiKey, cfg)
 		folders, err := api.GetFolders(client, cfg.SpaceID)
 		if err != nil {
 			return err
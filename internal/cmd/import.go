@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/importer"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+var tasksImportCmd = &cobra.Command{
+	Use:   "import <file.csv|file.json|file.yaml>",
+	Short: "Create tasks from a CSV, JSON, or YAML file",
+	Long: `Create a task for each row of a CSV file, or each object of a JSON or
+YAML list. Columns named like task fields are used unless a mapping file
+maps fields to columns. A parent given as "#N", or as another row's ref,
+refers to that row.
+
+The task created for each row is written to a report. Running the import
+again with the same report skips rows already imported, so a partial
+failure can be resumed.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := args[0]
+
+		listArg, _ := cmd.Flags().GetString("list")
+		if listArg == "" {
+			return fmt.Errorf("--list flag is required")
+		}
+
+		var mapping importer.Mapping
+		if mappingPath, _ := cmd.Flags().GetString("mapping"); mappingPath != "" {
+			var err error
+			mapping, err = importer.LoadMapping(mappingPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		records, err := importer.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rows := make([]importer.Row, 0, len(records))
+		for _, record := range records {
+			rows = append(rows, mapping.Apply(record))
+		}
+
+		reportPath, _ := cmd.Flags().GetString("report")
+		if reportPath == "" {
+			reportPath = path + ".report.json"
+		}
+		report, err := importer.LoadReport(reportPath)
+		if err != nil {
+			return err
+		}
+		report.Source = path
+
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		listID, err := res.ResolveList(listArg)
+		if err != nil {
+			return err
+		}
+
+		im := &importer.Importer{
+			Report: report,
+			Log:    cmd.OutOrStdout(),
+			Create: func(row importer.Row, parentID string) (string, error) {
+				req, err := importTaskRequest(client, res, listID, row, parentID)
+				if err != nil {
+					return "", err
+				}
+				task, err := api.CreateTask(client, req)
+				if err != nil {
+					return "", err
+				}
+				if dryRun {
+					// Nothing was created; stand in for the task so that
+					// subtasks can still be checked.
+					return row.Ref, nil
+				}
+				return task.ID, nil
+			},
+		}
+		if !dryRun {
+			im.ReportPath = reportPath
+		}
+
+		if err := im.Run(rows); err != nil {
+			if !dryRun {
+				return fmt.Errorf("%w; fix the rows and run the import again to resume (report: %s)", err, reportPath)
+			}
+			return err
+		}
+		if !dryRun {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Report written to %s\n", reportPath)
+		}
+		return nil
+	},
+}
+
+// importTaskRequest builds the request creating a row's task, resolving
+// its status, assignees, and parent.
+func importTaskRequest(client *api.Client, res *resolver.Resolver, listID string, row importer.Row, parentID string) (api.CreateTaskRequest, error) {
+	req := api.CreateTaskRequest{
+		ListID:              listID,
+		Name:                row.Fields[importer.FieldName],
+		Description:         row.Fields[importer.FieldDescription],
+		MarkdownDescription: row.Fields[importer.FieldMarkdownDescription],
+		Tags:                importer.SplitList(row.Fields[importer.FieldTags]),
+		Parent:              parentID,
+	}
+	if req.Name == "" {
+		return req, fmt.Errorf("no %s", importer.FieldName)
+	}
+
+	var err error
+	if status := row.Fields[importer.FieldStatus]; status != "" {
+		if req.Status, err = resolveStatus(client, listID, status); err != nil {
+			return req, err
+		}
+	}
+	if priority := row.Fields[importer.FieldPriority]; priority != "" {
+		if req.Priority, err = api.ParsePriority(priority); err != nil {
+			return req, err
+		}
+	}
+	if req.Assignees, err = resolveUsers(res, importer.SplitList(row.Fields[importer.FieldAssignees])); err != nil {
+		return req, err
+	}
+	if due := row.Fields[importer.FieldDueDate]; due != "" {
+		if req.DueDate, req.DueDateTime, err = parseDateArg(due); err != nil {
+			return req, fmt.Errorf("%s: %w", importer.FieldDueDate, err)
+		}
+	}
+	if start := row.Fields[importer.FieldStartDate]; start != "" {
+		if req.StartDate, req.StartDateTime, err = parseDateArg(start); err != nil {
+			return req, fmt.Errorf("%s: %w", importer.FieldStartDate, err)
+		}
+	}
+	if estimate := row.Fields[importer.FieldTimeEstimate]; estimate != "" {
+		if req.TimeEstimate, err = parseDurationArg(estimate); err != nil {
+			return req, fmt.Errorf("%s: %w", importer.FieldTimeEstimate, err)
+		}
+	}
+	if points := row.Fields[importer.FieldPoints]; points != "" {
+		value, err := strconv.ParseFloat(points, 64)
+		if err != nil {
+			return req, fmt.Errorf("invalid %s %q", importer.FieldPoints, points)
+		}
+		req.Points = &value
+	}
+	if parent := row.Fields[importer.FieldParent]; parent != "" && parentID == "" {
+		if req.Parent, err = res.ResolveTask(parent); err != nil {
+			return req, fmt.Errorf("failed to resolve parent task %q: %w", parent, err)
+		}
+	}
+	return req, nil
+}
+
+func init() {
+	tasksCmd.AddCommand(tasksImportCmd)
+	tasksImportCmd.Flags().StringP("list", "l", "", "list to create the tasks in (name, ID, or URL)")
+	tasksImportCmd.Flags().StringP("mapping", "m", "", "YAML or JSON file mapping task fields to column names")
+	tasksImportCmd.Flags().String("report", "", "report file recording the task created for each row (default <file>.report.json)")
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/importer"
+)
+
+func TestTasksImport(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "backlog.csv")
+	os.WriteFile(path, []byte("name,status,priority,parent,assignees\nEpic,In Prog,high,,\"7,8\"\nStory,,,#1,\n"), 0o644)
+
+	requests, err := runWithServer(t, tasksImportCmd, []string{"--list", "list456", path})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	creates := mutations(requests)
+	if len(creates) != 2 {
+		t.Fatalf("expected 2 tasks created, got %d", len(creates))
+	}
+	epic, story := creates[0].Body, creates[1].Body
+	if epic["name"] != "Epic" || epic["status"] != "in progress" || epic["priority"] != float64(2) {
+		t.Errorf("unexpected epic payload %v", epic)
+	}
+	if assignees, _ := epic["assignees"].([]any); len(assignees) != 2 {
+		t.Errorf("expected 2 assignees, got %v", epic["assignees"])
+	}
+	if story["parent"] != "task123" {
+		t.Errorf("expected the story under the created epic, got %v", story["parent"])
+	}
+
+	report, err := importer.LoadReport(path + ".report.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Rows) != 2 || report.Rows[0].TaskID != "task123" || report.Rows[0].Key == "" {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
+
+func TestTasksImportInvalidRow(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backlog.json")
+	os.WriteFile(path, []byte(`[{"name": "Good"}, {"name": "Bad", "status": "shipped"}]`), 0o644)
+
+	requests, err := runWithServer(t, tasksImportCmd, []string{"--list", "list456", path})
+	if err == nil || !strings.Contains(err.Error(), "1 of 2 rows failed") {
+		t.Errorf("expected one failed row, got %v", err)
+	}
+	if creates := mutations(requests); len(creates) != 1 {
+		t.Errorf("expected the valid row to be created, got %v", creates)
+	}
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+// stdinArg is the flag value that reads text from standard input.
+const stdinArg = "-"
+
+// readTextArg expands a text flag value: "-" reads stdin and "@path" reads
+// the file at path. A leading "@@" escapes a literal "@". Any other value
+// is returned as is. Trailing newlines are trimmed from text that is read.
+func readTextArg(value string, stdin io.Reader) (string, error) {
+	var (
+		content []byte
+		err     error
+	)
+	switch {
+	case strings.HasPrefix(value, "@@"):
+		return value[1:], nil
+	case value == stdinArg:
+		content, err = io.ReadAll(stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read stdin: %w", err)
+		}
+	case strings.HasPrefix(value, "@") && len(value) > 1:
+		content, err = os.ReadFile(value[1:])
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", value[1:], err)
+		}
+	default:
+		return value, nil
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04"
+)
+
+// parseDateArg parses a date flag given as YYYY-MM-DD or YYYY-MM-DD HH:MM
+// in local time, or as a ClickUp timestamp in milliseconds. It returns the
+// timestamp and whether the value carries a time of day.
+func parseDateArg(value string) (int64, bool, error) {
+	value = strings.TrimSpace(value)
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil && len(value) > len(dateLayout) {
+		return ms, true, nil
+	}
+	if t, err := time.ParseInLocation(dateLayout, value, time.Local); err == nil {
+		return t.UnixMilli(), false, nil
+	}
+	if t, err := time.ParseInLocation(dateTimeLayout, value, time.Local); err == nil {
+		return t.UnixMilli(), true, nil
+	}
+	return 0, false, fmt.Errorf("invalid date %q (expected YYYY-MM-DD, YYYY-MM-DD HH:MM, or a timestamp in milliseconds)", value)
+}
+
+// parseDurationArg parses a duration such as 1h30m into milliseconds.
+func parseDurationArg(value string) (int64, error) {
+	d, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q (expected a positive duration like 45m or 1h30m)", value)
+	}
+	return d.Milliseconds(), nil
+}
+
+// parseCustomFieldArgs parses repeated --field ID=VALUE flags. Values that
+// are valid JSON, such as numbers, booleans, and arrays, are sent as typed
+// values; anything else is sent as a string.
+func parseCustomFieldArgs(values []string) ([]api.CustomFieldValue, error) {
+	var fields []api.CustomFieldValue
+	for _, arg := range values {
+		id, raw, found := strings.Cut(arg, "=")
+		id = strings.TrimSpace(id)
+		if !found || id == "" {
+			return nil, fmt.Errorf("invalid custom field %q (expected FIELD_ID=VALUE)", arg)
+		}
+
+		var value any = raw
+		if json.Valid([]byte(raw)) {
+			if err := json.Unmarshal([]byte(raw), &value); err != nil {
+				return nil, err
+			}
+		}
+		fields = append(fields, api.CustomFieldValue{ID: id, Value: value})
+	}
+	return fields, nil
+}
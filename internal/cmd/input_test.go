@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadTextArgInline(t *testing.T) {
+	for _, value := range []string{"", "plain text", "@", "email me@example.com"} {
+		got, err := readTextArg(value, strings.NewReader("ignored"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != value {
+			t.Errorf("expected %q unchanged, got %q", value, got)
+		}
+	}
+}
+
+func TestReadTextArgEscapedAt(t *testing.T) {
+	got, err := readTextArg("@@channel ship it", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "@channel ship it" {
+		t.Errorf("expected a literal @, got %q", got)
+	}
+}
+
+func TestReadTextArgStdin(t *testing.T) {
+	got, err := readTextArg("-", strings.NewReader("## Release notes\n\n- fixed login\n\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "## Release notes\n\n- fixed login" {
+		t.Errorf("unexpected stdin content: %q", got)
+	}
+}
+
+func TestReadTextArgFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.md")
+	if err := os.WriteFile(path, []byte("From a file\r\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readTextArg("@"+path, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "From a file" {
+		t.Errorf("unexpected file content: %q", got)
+	}
+}
+
+func TestReadTextArgMissingFile(t *testing.T) {
+	_, err := readTextArg("@"+filepath.Join(t.TempDir(), "missing.md"), nil)
+	if err == nil {
+		t.Error("expected error for a missing file")
+	}
+}
+
+func TestParseDateArg(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		hasTime bool
+	}{
+		{"2025-01-15", time.Date(2025, 1, 15, 0, 0, 0, 0, time.Local).UnixMilli(), false},
+		{"2025-01-15 09:30", time.Date(2025, 1, 15, 9, 30, 0, 0, time.Local).UnixMilli(), true},
+		{"1736899200000", 1736899200000, true},
+	}
+	for _, tt := range tests {
+		got, hasTime, err := parseDateArg(tt.value)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.value, err)
+		}
+		if got != tt.want || hasTime != tt.hasTime {
+			t.Errorf("%s: expected (%d, %v), got (%d, %v)", tt.value, tt.want, tt.hasTime, got, hasTime)
+		}
+	}
+
+	for _, value := range []string{"tomorrow", "2025-13-01", "15/01/2025", "2025"} {
+		if _, _, err := parseDateArg(value); err == nil {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+}
+
+func TestParseDurationArg(t *testing.T) {
+	got, err := parseDurationArg("1h30m")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 5400000 {
+		t.Errorf("expected 5400000ms, got %d", got)
+	}
+
+	for _, value := range []string{"90", "-1h", "0s", "soon"} {
+		if _, err := parseDurationArg(value); err == nil {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+}
+
+func TestParseCustomFieldArgs(t *testing.T) {
+	fields, err := parseCustomFieldArgs([]string{"f1=42", "f2=high priority", "f3=[\"a\",\"b\"]", "f4=true", "f5="})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fields) != 5 {
+		t.Fatalf("expected 5 fields, got %d", len(fields))
+	}
+	if fields[0].ID != "f1" || fields[0].Value != float64(42) {
+		t.Errorf("expected numeric value, got %+v", fields[0])
+	}
+	if fields[1].Value != "high priority" {
+		t.Errorf("expected string value, got %+v", fields[1])
+	}
+	if values, ok := fields[2].Value.([]any); !ok || len(values) != 2 {
+		t.Errorf("expected array value, got %+v", fields[2])
+	}
+	if fields[3].Value != true {
+		t.Errorf("expected boolean value, got %+v", fields[3])
+	}
+	if fields[4].Value != "" {
+		t.Errorf("expected empty string value, got %+v", fields[4])
+	}
+
+	for _, arg := range []string{"no-equals", "=value"} {
+		if _, err := parseCustomFieldArgs([]string{arg}); err == nil {
+			t.Errorf("expected error for %q", arg)
+		}
+	}
+}
@@ -29,7 +29,7 @@ var listsListCmd = &cobra.Command{
 		}
 
 		cfg := GetConfig()
-		client := api.NewClient(apiKey, cfg.BaseURL, cfg.SpaceID)
+		client := newClient(apiKey, cfg)
 		res := resolver.New(client, cfg.StrictResolve)
 
 		folderID, err := res.ResolveFolder(folderArg)
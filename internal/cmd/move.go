@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/config"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+var tasksMoveCmd = &cobra.Command{
+	Use:   "move <task-id|name|url>",
+	Short: "Move a task and its subtasks to another list",
+	Long: `Move a task to another list, keeping its history. Subtasks move with
+their parent. Statuses that don't exist in the destination list are mapped
+to one with the same name, then to the first status of the same type; use
+--status-map to choose the destination status yourself.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		listArg, _ := cmd.Flags().GetString("to-list")
+		if listArg == "" {
+			return fmt.Errorf("--to-list flag is required")
+		}
+
+		statusMapArgs, _ := cmd.Flags().GetStringArray("status-map")
+		explicit, err := parseStatusMapArgs(statusMapArgs)
+		if err != nil {
+			return err
+		}
+
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		taskID, err := res.ResolveTask(args[0])
+		if err != nil {
+			return err
+		}
+
+		listID, err := res.ResolveList(listArg)
+		if err != nil {
+			return err
+		}
+
+		task, err := api.GetTask(client, taskID)
+		if err != nil {
+			return err
+		}
+		if task.ListID == listID {
+			fmt.Fprintf(cmd.OutOrStdout(), "Task %s is already in list %s\n", taskID, listID)
+			return nil
+		}
+
+		workspaceID, err := resolveWorkspace(client, cfg)
+		if err != nil {
+			return err
+		}
+
+		source, err := api.GetListStatuses(client, task.ListID)
+		if err != nil {
+			return err
+		}
+		destination, err := api.GetListStatuses(client, listID)
+		if err != nil {
+			return err
+		}
+		mappings, err := mapStatuses(source, destination, explicit)
+		if err != nil {
+			return err
+		}
+
+		req := api.MoveTaskRequest{StatusMappings: mappings}
+		if err := api.MoveTask(client, workspaceID, taskID, listID, req); err != nil {
+			return err
+		}
+
+		subtasks, err := moveSubtasksLeftBehind(client, workspaceID, taskID, listID, req)
+		if err != nil {
+			return err
+		}
+
+		var result strings.Builder
+		fmt.Fprintf(&result, "Task %s moved to list %s", taskID, listID)
+		switch {
+		case subtasks == 1:
+			result.WriteString(", moving 1 subtask that stayed behind")
+		case subtasks > 1:
+			fmt.Fprintf(&result, ", moving %d subtasks that stayed behind", subtasks)
+		}
+		fmt.Fprintln(&result)
+		for _, mapping := range mappings {
+			fmt.Fprintf(&result, "  status %s → %s\n", mapping.SourceStatus, mapping.DestinationStatus)
+		}
+		printResult(cmd.OutOrStdout(), "%s", result.String())
+		return nil
+	},
+}
+
+// moveSubtasksLeftBehind moves the subtasks of a moved task that are not
+// yet in listID, returning how many it moved. Subtasks usually follow
+// their parent, so which ones stayed behind is only known once the parent
+// has moved; under --dry-run it hasn't, and none are moved.
+func moveSubtasksLeftBehind(client *api.Client, workspaceID, taskID, listID string, req api.MoveTaskRequest) (int, error) {
+	if dryRun {
+		return 0, nil
+	}
+	moved, err := api.GetTask(client, taskID)
+	if err != nil {
+		return 0, err
+	}
+	subtasks := 0
+	for _, subtask := range flattenSubtasks(moved.Subtasks) {
+		if subtask.ListID != "" && subtask.ListID != listID {
+			if err := api.MoveTask(client, workspaceID, subtask.ID, listID, req); err != nil {
+				return subtasks, fmt.Errorf("failed to move subtask %s: %w", subtask.ID, err)
+			}
+			subtasks++
+		}
+	}
+	return subtasks, nil
+}
+
+// resolveWorkspace returns the configured workspace ID, or the only
+// workspace the API key can access.
+func resolveWorkspace(client *api.Client, cfg *config.Config) (string, error) {
+	if cfg.WorkspaceID != "" {
+		return cfg.WorkspaceID, nil
+	}
+
+	workspaces, err := api.GetWorkspaces(client)
+	if err != nil {
+		return "", err
+	}
+	switch len(workspaces) {
+	case 0:
+		return "", fmt.Errorf("no workspaces found for this API key")
+	case 1:
+		return workspaces[0].ID, nil
+	}
+
+	var names []string
+	for _, w := range workspaces {
+		names = append(names, fmt.Sprintf("%s (%s)", w.Name, w.ID))
+	}
+	return "", fmt.Errorf("multiple workspaces found, set workspace_id in the config file: %s", strings.Join(names, ", "))
+}
+
+// parseStatusMapArgs parses repeated --status-map FROM=TO flags, keyed by
+// the lowercased source status.
+func parseStatusMapArgs(values []string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	for _, arg := range values {
+		from, to, found := strings.Cut(arg, "=")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !found || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid status mapping %q (expected FROM=TO)", arg)
+		}
+		mapping[strings.ToLower(from)] = to
+	}
+	return mapping, nil
+}
+
+// mapStatuses maps every source status missing from the destination list
+// to a destination status: the one given in explicit, otherwise the first
+// destination status of the same type, otherwise the first status.
+func mapStatuses(source, destination []api.Status, explicit map[string]string) ([]api.StatusMapping, error) {
+	if len(destination) == 0 {
+		return nil, nil
+	}
+
+	var mappings []api.StatusMapping
+	for _, status := range source {
+		target := ""
+		if to, ok := explicit[strings.ToLower(status.Status)]; ok {
+			match, err := api.MatchStatus(destination, to)
+			if err != nil {
+				return nil, fmt.Errorf("--status-map %s: %w", status.Status, err)
+			}
+			target = match.Status
+		} else if match, err := api.MatchStatus(destination, status.Status); err == nil && strings.EqualFold(match.Status, status.Status) {
+			continue
+		} else {
+			target = destination[0].Status
+			for _, candidate := range destination {
+				if candidate.Type == status.Type {
+					target = candidate.Status
+					break
+				}
+			}
+		}
+		if !strings.EqualFold(target, status.Status) {
+			mappings = append(mappings, api.StatusMapping{SourceStatus: status.Status, DestinationStatus: target})
+		}
+	}
+	return mappings, nil
+}
+
+// flattenSubtasks lists every subtask in a tree, parents before children.
+func flattenSubtasks(tasks []api.Task) []api.Task {
+	var flat []api.Task
+	for _, task := range tasks {
+		flat = append(flat, task)
+		flat = append(flat, flattenSubtasks(task.Subtasks)...)
+	}
+	return flat
+}
+
+func init() {
+	tasksCmd.AddCommand(tasksMoveCmd)
+	tasksMoveCmd.Flags().String("to-list", "", "destination list name, ID, or URL")
+	tasksMoveCmd.Flags().StringArray("status-map", nil, "map a status missing from the destination as FROM=TO (repeatable)")
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+func TestTasksMoveCommand(t *testing.T) {
+	if tasksMoveCmd.Use != "move <task-id|name|url>" {
+		t.Errorf("unexpected Use: %s", tasksMoveCmd.Use)
+	}
+	for _, name := range []string{"to-list", "status-map"} {
+		if tasksMoveCmd.Flags().Lookup(name) == nil {
+			t.Errorf("expected --%s flag", name)
+		}
+	}
+}
+
+func TestParseStatusMapArgs(t *testing.T) {
+	mapping, err := parseStatusMapArgs([]string{"In Review=review", " blocked = to do "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mapping["in review"] != "review" || mapping["blocked"] != "to do" {
+		t.Errorf("unexpected mapping %v", mapping)
+	}
+
+	for _, arg := range []string{"review", "=review", "review="} {
+		if _, err := parseStatusMapArgs([]string{arg}); err == nil {
+			t.Errorf("expected error for %q", arg)
+		}
+	}
+}
+
+func TestMapStatuses(t *testing.T) {
+	source := []api.Status{
+		{Status: "to do", Type: "open"},
+		{Status: "in review", Type: "custom"},
+		{Status: "blocked", Type: "custom"},
+		{Status: "shipped", Type: "closed"},
+	}
+
+	mappings, err := mapStatuses(source, workflowStatuses, map[string]string{"blocked": "to"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []api.StatusMapping{
+		{SourceStatus: "in review", DestinationStatus: "in progress"},
+		{SourceStatus: "blocked", DestinationStatus: "to do"},
+		{SourceStatus: "shipped", DestinationStatus: "closed"},
+	}
+	if len(mappings) != len(want) {
+		t.Fatalf("expected %v, got %v", want, mappings)
+	}
+	for i := range want {
+		if mappings[i] != want[i] {
+			t.Errorf("mapping %d: expected %v, got %v", i, want[i], mappings[i])
+		}
+	}
+}
+
+func TestMapStatusesInvalidExplicit(t *testing.T) {
+	source := []api.Status{{Status: "blocked", Type: "custom"}}
+	if _, err := mapStatuses(source, workflowStatuses, map[string]string{"blocked": "shipped"}); err == nil {
+		t.Error("expected error mapping to a status missing from the destination")
+	}
+}
+
+func TestTasksMoveSameList(t *testing.T) {
+	requests, err := runWithServer(t, tasksMoveCmd, []string{"task123", "--to-list", "list456"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changes := mutations(requests); len(changes) != 0 {
+		t.Errorf("expected no changes for a task already in the list, got %v", changes)
+	}
+}
+
+func TestTasksMoveCountsOnlyMovedSubtasks(t *testing.T) {
+	var out bytes.Buffer
+	tasksMoveCmd.SetOut(&out)
+	t.Cleanup(func() { tasksMoveCmd.SetOut(nil) })
+
+	response := `{"id": "task123", "list": "list1", "teams": [{"id": "w1"}], "subtasks": [
+		{"id": "followed", "list": "list456"},
+		{"id": "stayed", "list": "list1"}
+	]}`
+	requests, err := runWithServerResponse(t, tasksMoveCmd, response, []string{"task123", "--to-list", "list456"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if changes := mutations(requests); len(changes) != 2 {
+		t.Errorf("expected the task and one subtask moved, got %v", changes)
+	}
+	if !strings.Contains(out.String(), "moving 1 subtask that stayed behind\n") {
+		t.Errorf("expected only the moved subtask counted, got %q", out.String())
+	}
+}
+
+func TestMoveSubtasksLeftBehindDryRun(t *testing.T) {
+	useDryRun(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "task123", "list": "list1", "subtasks": [{"id": "sub1", "list": "list1"}]}`))
+	}))
+	defer server.Close()
+	client := api.NewClient("key", server.URL, "")
+	var out bytes.Buffer
+	client.SetDryRun(&out)
+
+	moved, err := moveSubtasksLeftBehind(client, "w1", "task123", "list456", api.MoveTaskRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if moved != 0 || out.Len() != 0 {
+		t.Errorf("expected no subtask moves under dry-run, got %d and %q", moved, out.String())
+	}
+}
@@ -2,9 +2,12 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
 	"github.com/Fire-Dragon-DoL/clickup-cli/internal/config"
 	"github.com/Fire-Dragon-DoL/clickup-cli/internal/keyring"
 	"github.com/Fire-Dragon-DoL/clickup-cli/internal/output"
@@ -16,6 +19,10 @@ var (
 	spaceID      string
 	outputFormat string
 	strictResolve bool
+	templateText string
+	templateFile string
+	colorMode    string
+	dryRun       bool
 
 	cfg       *config.Config
 	kr        *keyring.Keyring
@@ -40,8 +47,25 @@ Configure your ClickUp space and API key to get started.`,
 			}
 		}
 
+		if outputFormat == "" && (templateText != "" || templateFile != "") {
+			outputFormat = "template"
+		}
+
 		cfg.ApplyCLIOverrides(spaceID, outputFormat, strictResolve)
-		formatter = output.NewFormatter(cfg.OutputFormat)
+		var err error
+		formatter, err = output.NewFormatter(cfg.OutputFormat)
+		if err != nil {
+			return err
+		}
+		if err := loadTemplate(formatter); err != nil {
+			return err
+		}
+		formatter.SetWidth(output.TerminalWidth(os.Stdout))
+		color, err := output.ColorEnabled(colorMode, os.Stdout)
+		if err != nil {
+			return err
+		}
+		formatter.SetColor(color)
 		kr = keyring.New(keyring.NewSystemProvider())
 
 		return nil
@@ -55,8 +79,30 @@ func Execute() error {
 func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file path")
 	rootCmd.PersistentFlags().StringVar(&spaceID, "space", "", "ClickUp space ID")
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "", "output format (text|json)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "", fmt.Sprintf("output format (%s)", strings.Join(output.Formats, "|")))
 	rootCmd.PersistentFlags().BoolVar(&strictResolve, "strict", false, "fail on ambiguous name resolution")
+	rootCmd.PersistentFlags().StringVar(&templateText, "template", "", "Go template for --output template")
+	rootCmd.PersistentFlags().StringVar(&templateFile, "template-file", "", "file containing a Go template for --output template")
+	rootCmd.MarkFlagsMutuallyExclusive("template", "template-file")
+	rootCmd.PersistentFlags().StringVar(&colorMode, "color", "auto", "colorize output (auto|always|never)")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "print the requests that would change data instead of sending them")
+}
+
+// loadTemplate sets the template from --template, with \t and \n
+// expanded, or from --template-file as written.
+func loadTemplate(f *output.Formatter) error {
+	text := output.ExpandEscapes(templateText)
+	if templateFile != "" {
+		b, err := os.ReadFile(templateFile)
+		if err != nil {
+			return fmt.Errorf("failed to read template file: %w", err)
+		}
+		text = strings.TrimSuffix(string(b), "\n")
+	}
+	if text == "" {
+		return nil
+	}
+	return f.SetTemplate(text)
 }
 
 func defaultConfigPath() string {
@@ -71,6 +117,16 @@ func GetConfig() *config.Config {
 	return cfg
 }
 
+// newClient creates an API client from the config, printing requests
+// instead of sending them when --dry-run is set.
+func newClient(apiKey string, cfg *config.Config) *api.Client {
+	client := api.NewClient(apiKey, cfg.BaseURL, cfg.SpaceID)
+	if dryRun {
+		client.SetDryRun(os.Stdout)
+	}
+	return client
+}
+
 func GetKeyring() *keyring.Keyring {
 	return kr
 }
@@ -84,6 +140,34 @@ func PrintOutput(data any) error {
 	if err != nil {
 		return err
 	}
-	fmt.Println(out)
+	writeFormatted(os.Stdout, out)
 	return nil
 }
+
+// printChanged prints data describing a change like PrintOutput. Under
+// --dry-run nothing changed, so it prints nothing.
+func printChanged(data any) error {
+	if dryRun {
+		return nil
+	}
+	return PrintOutput(data)
+}
+
+// printResult reports the outcome of a change. Under --dry-run nothing
+// changed, so it reports nothing.
+func printResult(w io.Writer, format string, args ...any) {
+	if dryRun {
+		return
+	}
+	fmt.Fprintf(w, format, args...)
+}
+
+// writeFormatted writes formatted output followed by a newline. Empty
+// ndjson output, such as an empty page of tasks, writes nothing, since a
+// blank line isn't a valid record.
+func writeFormatted(w io.Writer, out string) {
+	if out == "" && formatter.Streaming() {
+		return
+	}
+	fmt.Fprintln(w, out)
+}
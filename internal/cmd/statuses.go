@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+var statusesCmd = &cobra.Command{
+	Use:   "statuses <list-id|name|url>",
+	Short: "List the statuses of a list",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		kr := GetKeyring()
+		apiKey, err := kr.GetAPIKey()
+		if err != nil {
+			return err
+		}
+
+		cfg := GetConfig()
+		client := newClient(apiKey, cfg)
+		res := resolver.New(client, cfg.StrictResolve)
+
+		listID, err := res.ResolveList(args[0])
+		if err != nil {
+			return err
+		}
+
+		statuses, err := api.GetListStatuses(client, listID)
+		if err != nil {
+			return err
+		}
+
+		return PrintOutput(buildStatusesView(statuses))
+	},
+}
+
+type listStatusView struct {
+	Status string
+	Type   string
+
+	color string
+}
+
+// FieldColor shows each status in its ClickUp color in text and table output.
+func (v listStatusView) FieldColor(name string) string {
+	if name == "Status" {
+		return v.color
+	}
+	return ""
+}
+
+func buildStatusesView(statuses []api.Status) []listStatusView {
+	views := []listStatusView{}
+	for _, status := range statuses {
+		views = append(views, listStatusView{
+			Status: status.Status,
+			Type:   status.Type,
+			color:  status.Color,
+		})
+	}
+	return views
+}
+
+// resolveStatus validates a status against the statuses of a list and
+// returns the status's exact name. Case-insensitive and unique-prefix
+// matches are accepted.
+func resolveStatus(client *api.Client, listID, input string) (string, error) {
+	statuses, err := api.GetListStatuses(client, listID)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch statuses for list %s: %w", listID, err)
+	}
+	status, err := api.MatchStatus(statuses, input)
+	if err != nil {
+		return "", err
+	}
+	return status.Status, nil
+}
+
+func init() {
+	rootCmd.AddCommand(statusesCmd)
+}
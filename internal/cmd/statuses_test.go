@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+func TestStatusesCmd(t *testing.T) {
+	cmd := statusesCmd
+	if cmd.Use != "statuses <list-id|name|url>" {
+		t.Errorf("expected Use 'statuses <list-id|name|url>', got '%s'", cmd.Use)
+	}
+	if cmd.Short == "" {
+		t.Error("expected non-empty Short description")
+	}
+}
+
+func TestBuildStatusesView(t *testing.T) {
+	statuses := []api.Status{
+		{Status: "to do", Color: "#d3d3d3", Type: "open"},
+		{Status: "done", Color: "#6bc950", Type: "closed"},
+	}
+
+	views := buildStatusesView(statuses)
+
+	if len(views) != 2 {
+		t.Fatalf("expected 2 statuses, got %d", len(views))
+	}
+	if views[1].Status != "done" || views[1].FieldColor("Status") != "#6bc950" || views[1].Type != "closed" {
+		t.Errorf("unexpected status view: %+v", views[1])
+	}
+}
@@ -2,8 +2,14 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/output"
 	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
 	"github.com/spf13/cobra"
 )
@@ -27,6 +33,12 @@ var tasksListCmd = &cobra.Command{
 			return err
 		}
 
+		fieldsArg, _ := cmd.Flags().GetString("fields")
+		fields, err := parseFields(fieldsArg)
+		if err != nil {
+			return err
+		}
+
 		kr := GetKeyring()
 		apiKey, err := kr.GetAPIKey()
 		if err != nil {
@@ -34,7 +46,7 @@ var tasksListCmd = &cobra.Command{
 		}
 
 		cfg := GetConfig()
-		client := api.NewClient(apiKey, cfg.BaseURL, cfg.SpaceID)
+		client := newClient(apiKey, cfg)
 		res := resolver.New(client, cfg.StrictResolve)
 
 		listID, err := res.ResolveList(listArg)
@@ -42,17 +54,35 @@ var tasksListCmd = &cobra.Command{
 			return err
 		}
 
-		resp, err := api.GetTasks(client, listID, recursive)
+		archived, _ := cmd.Flags().GetBool("archived")
+		opts := api.TaskListOptions{Subtasks: recursive, Archived: archived}
+		formatter := GetFormatter()
+		if formatter.Streaming() {
+			return api.EachTaskPage(client, listID, opts, func(tasks []api.Task) error {
+				formatted, err := formatTasksListView(tasks, fields...)
+				if err != nil {
+					return err
+				}
+				writeFormatted(os.Stdout, formatted)
+				return nil
+			})
+		}
+
+		var tasks []api.Task
+		err = api.EachTaskPage(client, listID, opts, func(page []api.Task) error {
+			tasks = append(tasks, page...)
+			return nil
+		})
 		if err != nil {
 			return err
 		}
 
-		formatted, err := formatTasksListView(resp.Tasks)
+		formatted, err := formatTasksListView(tasks, fields...)
 		if err != nil {
 			return err
 		}
 
-		fmt.Println(formatted)
+		writeFormatted(os.Stdout, formatted)
 		return nil
 	},
 }
@@ -71,6 +101,12 @@ var tasksCreateCmd = &cobra.Command{
 			return fmt.Errorf("--list flag is required")
 		}
 
+		priorityArg, _ := cmd.Flags().GetString("priority")
+		priority, err := api.ParsePriority(priorityArg)
+		if err != nil {
+			return err
+		}
+
 		kr := GetKeyring()
 		apiKey, err := kr.GetAPIKey()
 		if err != nil {
@@ -78,7 +114,7 @@ var tasksCreateCmd = &cobra.Command{
 		}
 
 		cfg := GetConfig()
-		client := api.NewClient(apiKey, cfg.BaseURL, cfg.SpaceID)
+		client := newClient(apiKey, cfg)
 		res := resolver.New(client, cfg.StrictResolve)
 
 		listID, err := res.ResolveList(listArg)
@@ -86,102 +122,267 @@ var tasksCreateCmd = &cobra.Command{
 			return err
 		}
 
-		payload := map[string]any{
-			"name":    title,
-			"list_id": listID,
+		req := api.CreateTaskRequest{
+			ListID: listID,
+			Name:   title,
 		}
 
 		description, _ := cmd.Flags().GetString("description")
-		if description != "" {
-			payload["description"] = description
+		req.Description, err = readTextArg(description, cmd.InOrStdin())
+		if err != nil {
+			return err
 		}
 
-		priority, _ := cmd.Flags().GetInt("priority")
-		if priority != 0 {
-			payload["priority"] = priority
+		markdown, _ := cmd.Flags().GetString("markdown-description")
+		req.MarkdownDescription, err = readTextArg(markdown, cmd.InOrStdin())
+		if err != nil {
+			return err
 		}
 
+		req.Priority = priority
+
 		status, _ := cmd.Flags().GetString("status")
 		if status != "" {
-			payload["status"] = status
+			req.Status, err = resolveStatus(client, listID, status)
+			if err != nil {
+				return err
+			}
 		}
 
+		req.NotifyAll, _ = cmd.Flags().GetBool("notify-all")
+		req.Tags, _ = cmd.Flags().GetStringArray("tag")
+
 		dueDate, _ := cmd.Flags().GetString("due")
 		if dueDate != "" {
-			payload["due_date"] = dueDate
+			req.DueDate, req.DueDateTime, err = parseDateArg(dueDate)
+			if err != nil {
+				return fmt.Errorf("--due: %w", err)
+			}
 		}
 
-		assignee, _ := cmd.Flags().GetString("assignee")
-		if assignee != "" {
+		startDate, _ := cmd.Flags().GetString("start")
+		if startDate != "" {
+			req.StartDate, req.StartDateTime, err = parseDateArg(startDate)
+			if err != nil {
+				return fmt.Errorf("--start: %w", err)
+			}
+		}
+
+		estimate, _ := cmd.Flags().GetString("estimate")
+		if estimate != "" {
+			req.TimeEstimate, err = parseDurationArg(estimate)
+			if err != nil {
+				return fmt.Errorf("--estimate: %w", err)
+			}
+		}
+
+		if cmd.Flags().Changed("points") {
+			points, _ := cmd.Flags().GetFloat64("points")
+			req.Points = &points
+		}
+
+		fieldArgs, _ := cmd.Flags().GetStringArray("field")
+		req.CustomFields, err = parseCustomFieldArgs(fieldArgs)
+		if err != nil {
+			return err
+		}
+
+		assignees, _ := cmd.Flags().GetStringArray("assignee")
+		for _, assignee := range assignees {
 			assigneeID, err := res.ResolveUser(assignee)
 			if err != nil {
 				return err
 			}
-			payload["assignee"] = assigneeID
+			req.Assignees = append(req.Assignees, assigneeID)
 		}
 
 		parent, _ := cmd.Flags().GetString("parent")
 		if parent != "" {
-			parentID, err := res.ResolveTask(parent)
+			req.Parent, err = res.ResolveTask(parent)
 			if err != nil {
 				return err
 			}
-			payload["parent"] = parentID
 		}
 
-		task, err := api.CreateTask(client, payload)
-		if err != nil {
-			return err
+		linksTo, _ := cmd.Flags().GetString("links-to")
+		if linksTo != "" {
+			req.LinksTo, err = res.ResolveTask(linksTo)
+			if err != nil {
+				return fmt.Errorf("failed to resolve linked task: %w", err)
+			}
 		}
 
-		formatted, err := formatTaskDetailsView(task)
+		task, err := api.CreateTask(client, req)
 		if err != nil {
 			return err
 		}
 
-		fmt.Println(formatted)
-		return nil
+		return printTaskResult(task)
 	},
 }
 
-func formatTasksListView(tasks []api.Task) (string, error) {
+type taskListView struct {
+	ID       string
+	Title    string
+	Assignee string
+	Status   string
+	Priority string
+
+	level  int
+	colors map[string]string
+}
+
+// IndentLevel nests subtasks under their parent in text and table output.
+func (v taskListView) IndentLevel() int {
+	return v.level
+}
+
+// FieldColor colors the status and priority in text and table output.
+func (v taskListView) FieldColor(name string) string {
+	return v.colors[name]
+}
+
+// formatTasksListView renders the list view. When fields are given, only
+// those task attributes are shown, in that order. "all" shows full tasks
+// in structured output and every field in the others.
+func formatTasksListView(tasks []api.Task, fields ...string) (string, error) {
 	formatter := GetFormatter()
+	if len(fields) == 0 && formatter.Markdown() {
+		return formatter.FormatTaskList(nestTaskTree(tasks), true)
+	}
+	if len(fields) == 0 {
+		return formatter.Format(buildTasksListView(tasks))
+	}
 
-	type TaskListView struct {
-		ID       string
-		Title    string
-		Assignee string
-		Status   string
-		Priority string
+	nested := orderTaskTree(tasks)
+	if fields[0] == allFields && formatter.Structured() {
+		ordered := make([]api.Task, len(nested))
+		for i, n := range nested {
+			ordered[i] = n.task
+		}
+		return formatter.Format(ordered)
+	}
+	if fields[0] == allFields {
+		fields = everyField()
 	}
+	return formatter.Format(selectTaskFields(nested, fields))
+}
 
-	var views []TaskListView
-	for _, task := range tasks {
-		view := TaskListView{
-			ID:    task.ID,
-			Title: task.Name,
+func buildTasksListView(tasks []api.Task) []taskListView {
+	var views []taskListView
+	for _, n := range orderTaskTree(tasks) {
+		task := n.task
+		view := taskListView{
+			ID:       task.ID,
+			Title:    task.Name,
+			Status:   taskStatus(task),
+			Priority: taskPriority(task),
+			level:    n.level,
+			colors:   taskColors(task, time.Now()),
 		}
 
 		if task.Assignee != nil {
 			view.Assignee = task.Assignee.Username
 		}
 
-		if task.Status != nil {
-			view.Status = task.Status.Status
+		views = append(views, view)
+	}
+	return views
+}
+
+type nestedTask struct {
+	task  api.Task
+	level int
+}
+
+// splitTaskTree separates root tasks from subtasks that the list endpoint
+// returns as flat entries referencing a parent in the same result.
+func splitTaskTree(tasks []api.Task) ([]api.Task, map[string][]api.Task) {
+	ids := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		ids[task.ID] = true
+	}
+
+	children := make(map[string][]api.Task)
+	var roots []api.Task
+	for _, task := range tasks {
+		if task.ParentID != "" && ids[task.ParentID] {
+			children[task.ParentID] = append(children[task.ParentID], task)
+			continue
+		}
+		roots = append(roots, task)
+	}
+	return roots, children
+}
+
+// nestTaskTree moves flat subtasks into their parent's Subtasks.
+func nestTaskTree(tasks []api.Task) []api.Task {
+	roots, children := splitTaskTree(tasks)
+
+	var nest func(task api.Task) api.Task
+	nest = func(task api.Task) api.Task {
+		subtasks := slices.Clone(task.Subtasks)
+		for _, child := range children[task.ID] {
+			subtasks = append(subtasks, nest(child))
 		}
+		task.Subtasks = subtasks
+		return task
+	}
+
+	nested := make([]api.Task, len(roots))
+	for i, root := range roots {
+		nested[i] = nest(root)
+	}
+	return nested
+}
 
-		if task.Priority != nil {
-			view.Priority = task.Priority.Priority
+// orderTaskTree orders tasks so that subtasks follow their parent.
+// Subtasks are either nested in Task.Subtasks or flat entries referencing
+// a parent in the same result.
+func orderTaskTree(tasks []api.Task) []nestedTask {
+	roots, children := splitTaskTree(tasks)
+
+	var ordered []nestedTask
+	var walk func(tasks []api.Task, level int)
+	walk = func(tasks []api.Task, level int) {
+		for _, task := range tasks {
+			ordered = append(ordered, nestedTask{task: task, level: level})
+			walk(task.Subtasks, level+1)
+			walk(children[task.ID], level+1)
 		}
+	}
+	walk(roots, 0)
 
-		views = append(views, view)
+	return ordered
+}
+
+// printTaskResult prints a created or updated task in the Details View. The
+// API returns nothing under --dry-run, so there is nothing to print.
+func printTaskResult(task api.Task) error {
+	if dryRun {
+		return nil
+	}
+	formatted, err := formatTaskDetailsView(task)
+	if err != nil {
+		return err
 	}
 
-	return formatter.Format(views)
+	fmt.Println(formatted)
+	return nil
 }
 
 func formatTaskDetailsView(task api.Task, comments ...api.Comment) (string, error) {
+	return renderTaskDetailsView(task, comments, false)
+}
+
+// renderTaskDetailsView renders the details view. Text output is laid out
+// as header fields followed by the description, rendered from markdown
+// unless raw is set, the checklists, and the comments thread.
+func renderTaskDetailsView(task api.Task, comments []api.Comment, raw bool) (string, error) {
 	formatter := GetFormatter()
+	if formatter.Markdown() {
+		return formatter.FormatTaskMarkdown(task, comments), nil
+	}
 
 	type CommentView struct {
 		Author  string
@@ -189,6 +390,18 @@ func formatTaskDetailsView(task api.Task, comments ...api.Comment) (string, erro
 		Date    string
 	}
 
+	type ChecklistItemView struct {
+		Name     string
+		Resolved bool
+		Assignee string
+	}
+
+	type ChecklistView struct {
+		Name  string
+		Done  string
+		Items []ChecklistItemView
+	}
+
 	type TaskDetailsView struct {
 		ID          string
 		Title       string
@@ -197,13 +410,21 @@ func formatTaskDetailsView(task api.Task, comments ...api.Comment) (string, erro
 		Status      string
 		Priority    string
 		DueDate     string
+		Checklists  []ChecklistView
 		Comments    []CommentView
 	}
 
+	description := task.MarkdownDescription
+	if description == "" {
+		description = task.Description
+	}
+
 	view := TaskDetailsView{
 		ID:          task.ID,
 		Title:       task.Name,
-		Description: task.Description,
+		Description: description,
+		Status:      taskStatus(task),
+		Priority:    taskPriority(task),
 		DueDate:     task.DueDate,
 	}
 
@@ -211,12 +432,16 @@ func formatTaskDetailsView(task api.Task, comments ...api.Comment) (string, erro
 		view.Assignee = task.Assignee.Username
 	}
 
-	if task.Status != nil {
-		view.Status = task.Status.Status
-	}
-
-	if task.Priority != nil {
-		view.Priority = task.Priority.Priority
+	for _, checklist := range task.Checklists {
+		checklistView := ChecklistView{Name: checklist.Name, Done: checklistProgress(checklist)}
+		for _, item := range checklist.Items {
+			itemView := ChecklistItemView{Name: item.Name, Resolved: item.Resolved}
+			if item.Assignee != nil {
+				itemView.Assignee = item.Assignee.Username
+			}
+			checklistView.Items = append(checklistView.Items, itemView)
+		}
+		view.Checklists = append(view.Checklists, checklistView)
 	}
 
 	if len(comments) > 0 {
@@ -229,7 +454,112 @@ func formatTaskDetailsView(task api.Task, comments ...api.Comment) (string, erro
 		}
 	}
 
-	return formatter.Format(view)
+	header := taskHeaderView{
+		ID:       view.ID,
+		Title:    view.Title,
+		Assignee: view.Assignee,
+		Status:   view.Status,
+		Priority: view.Priority,
+		Due:      formatTimestamp(view.DueDate),
+		colors:   taskColors(task, time.Now()),
+	}
+
+	if !raw {
+		description = formatter.RenderMarkdown(description)
+	}
+
+	var checklists []string
+	for _, checklist := range view.Checklists {
+		checklists = append(checklists, fmt.Sprintf("%s (%s)", checklist.Name, checklist.Done))
+		for _, item := range checklist.Items {
+			line := "  [ ] " + item.Name
+			if item.Resolved {
+				line = "  [x] " + item.Name
+			}
+			if item.Assignee != "" {
+				line += " · " + item.Assignee
+			}
+			checklists = append(checklists, line)
+		}
+	}
+
+	var thread []string
+	for _, comment := range view.Comments {
+		thread = append(thread, fmt.Sprintf("%s · %s", comment.Author, formatTimestamp(comment.Date)))
+		for _, line := range strings.Split(strings.TrimRight(comment.Content, "\n"), "\n") {
+			thread = append(thread, "  "+line)
+		}
+		thread = append(thread, "")
+	}
+
+	return formatter.FormatDetails(view, header,
+		output.Section{Title: "Description", Body: description},
+		output.Section{Title: "Checklists", Body: strings.Join(checklists, "\n")},
+		output.Section{Title: fmt.Sprintf("Comments (%d)", len(view.Comments)), Body: strings.Join(thread, "\n")},
+	)
+}
+
+// formatTimestamp turns a ClickUp timestamp (milliseconds since the epoch)
+// into a local date and time. Other values are returned unchanged.
+func formatTimestamp(value string) string {
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return value
+	}
+	return time.UnixMilli(ms).Format("2006-01-02 15:04")
+}
+
+// taskHeaderView is the header of the task details view.
+type taskHeaderView struct {
+	ID       string
+	Title    string
+	Assignee string
+	Status   string
+	Priority string
+	Due      string
+
+	colors map[string]string
+}
+
+func (v taskHeaderView) FieldColor(name string) string {
+	return v.colors[name]
+}
+
+func taskStatus(task api.Task) string {
+	if task.Status == nil {
+		return ""
+	}
+	return task.Status.Status
+}
+
+func taskPriority(task api.Task) string {
+	if task.Priority == nil {
+		return ""
+	}
+	return task.Priority.Priority
+}
+
+// taskColors maps the Status, Priority, and Due fields of a task view to
+// the colors text and table output show them in.
+func taskColors(task api.Task, now time.Time) map[string]string {
+	colors := map[string]string{"Due": dueDateColor(task, now)}
+	if task.Status != nil {
+		colors["Status"] = task.Status.Color
+	}
+	if task.Priority != nil {
+		colors["Priority"] = task.Priority.Color
+	}
+	return colors
+}
+
+// dueDateColor is red when an open task is overdue. ClickUp returns due
+// dates as milliseconds since the epoch.
+func dueDateColor(task api.Task, now time.Time) string {
+	ms, err := strconv.ParseInt(task.DueDate, 10, 64)
+	if err == nil && task.DateClosed == "" && time.UnixMilli(ms).Before(now) {
+		return "red"
+	}
+	return ""
 }
 
 var tasksShowCmd = &cobra.Command{
@@ -246,7 +576,7 @@ var tasksShowCmd = &cobra.Command{
 		}
 
 		cfg := GetConfig()
-		client := api.NewClient(apiKey, cfg.BaseURL, cfg.SpaceID)
+		client := newClient(apiKey, cfg)
 		res := resolver.New(client, cfg.StrictResolve)
 
 		taskID, err := res.ResolveTask(taskArg)
@@ -264,7 +594,8 @@ var tasksShowCmd = &cobra.Command{
 			return err
 		}
 
-		formatted, err := formatTaskDetailsView(task, comments...)
+		raw, _ := cmd.Flags().GetBool("raw")
+		formatted, err := renderTaskDetailsView(task, comments, raw)
 		if err != nil {
 			return err
 		}
@@ -275,11 +606,23 @@ var tasksShowCmd = &cobra.Command{
 }
 
 var tasksUpdateCmd = &cobra.Command{
-	Use:   "update <task-id|name|url>",
-	Short: "Update a task",
-	Args:  cobra.ExactArgs(1),
+	Use:   "update [task-id|name|url...]",
+	Short: "Update tasks",
+	Long: `Update one or more tasks. Select tasks by argument, with --stdin, or
+with --list and --where-status.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		taskArg := args[0]
+		// Build update request from flags, validating values before any
+		// request is made
+		var update taskUpdate
+
+		if cmd.Flags().Changed("priority") {
+			priorityArg, _ := cmd.Flags().GetString("priority")
+			priority, err := api.ParsePriority(priorityArg)
+			if err != nil {
+				return err
+			}
+			update.req.Priority = &priority
+		}
 
 		kr := GetKeyring()
 		apiKey, err := kr.GetAPIKey()
@@ -288,49 +631,86 @@ var tasksUpdateCmd = &cobra.Command{
 		}
 
 		cfg := GetConfig()
-		client := api.NewClient(apiKey, cfg.BaseURL, cfg.SpaceID)
+		client := newClient(apiKey, cfg)
 		res := resolver.New(client, cfg.StrictResolve)
 
-		taskID, err := res.ResolveTask(taskArg)
-		if err != nil {
-			return err
-		}
-
-		// Build update payload from flags
-		payload := make(map[string]any)
-
 		title, _ := cmd.Flags().GetString("title")
 		if title != "" {
-			payload["name"] = title
+			update.req.Name = &title
 		}
 
-		assignee, _ := cmd.Flags().GetString("assignee")
-		if assignee != "" {
-			assigneeID, err := res.ResolveUser(assignee)
-			if err != nil {
-				return fmt.Errorf("failed to resolve assignee: %w", err)
+		assignees, _ := cmd.Flags().GetStringArray("assignee")
+		addAssignees, _ := cmd.Flags().GetStringArray("add-assignee")
+		removeAssignees, _ := cmd.Flags().GetStringArray("remove-assignee")
+		update.replaceAssignees = cmd.Flags().Changed("assignee")
+		if update.replaceAssignees {
+			if update.addAssignees, err = resolveUsers(res, assignees); err != nil {
+				return err
+			}
+		} else if len(addAssignees) > 0 || len(removeAssignees) > 0 {
+			if update.addAssignees, err = resolveUsers(res, addAssignees); err != nil {
+				return err
+			}
+			if update.removeAssignees, err = resolveUsers(res, removeAssignees); err != nil {
+				return err
 			}
-			payload["assignee"] = assigneeID
 		}
 
-		status, _ := cmd.Flags().GetString("status")
-		if status != "" {
-			payload["status"] = status
-		}
+		update.status, _ = cmd.Flags().GetString("status")
 
-		priority, _ := cmd.Flags().GetString("priority")
-		if priority != "" {
-			payload["priority"] = priority
+		stdin, _ := cmd.Flags().GetBool("stdin")
+		description, _ := cmd.Flags().GetString("description")
+		markdown, _ := cmd.Flags().GetString("markdown-description")
+		if stdin && (description == "-" || markdown == "-") {
+			return fmt.Errorf("--stdin can't be combined with reading the description from stdin")
 		}
 
-		description, _ := cmd.Flags().GetString("description")
+		description, err = readTextArg(description, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
 		if description != "" {
-			payload["description"] = description
+			update.req.Description = &description
+		}
+
+		markdown, err = readTextArg(markdown, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+		if markdown != "" {
+			update.req.MarkdownDescription = &markdown
 		}
 
 		dueDate, _ := cmd.Flags().GetString("due")
 		if dueDate != "" {
-			payload["due_date"] = dueDate
+			due, hasTime, err := parseDateArg(dueDate)
+			if err != nil {
+				return fmt.Errorf("--due: %w", err)
+			}
+			update.req.DueDate, update.req.DueDateTime = &due, &hasTime
+		}
+
+		startDate, _ := cmd.Flags().GetString("start")
+		if startDate != "" {
+			start, hasTime, err := parseDateArg(startDate)
+			if err != nil {
+				return fmt.Errorf("--start: %w", err)
+			}
+			update.req.StartDate, update.req.StartDateTime = &start, &hasTime
+		}
+
+		estimate, _ := cmd.Flags().GetString("estimate")
+		if estimate != "" {
+			timeEstimate, err := parseDurationArg(estimate)
+			if err != nil {
+				return fmt.Errorf("--estimate: %w", err)
+			}
+			update.req.TimeEstimate = &timeEstimate
+		}
+
+		if cmd.Flags().Changed("points") {
+			points, _ := cmd.Flags().GetFloat64("points")
+			update.req.Points = &points
 		}
 
 		parent, _ := cmd.Flags().GetString("parent")
@@ -339,26 +719,143 @@ var tasksUpdateCmd = &cobra.Command{
 			if err != nil {
 				return fmt.Errorf("failed to resolve parent task: %w", err)
 			}
-			payload["parent"] = parentID
+			update.req.Parent = &parentID
 		}
 
-		// Perform update
-		updated, err := api.UpdateTask(client, taskID, payload)
+		fieldArgs, _ := cmd.Flags().GetStringArray("field")
+		update.customFields, err = parseCustomFieldArgs(fieldArgs)
 		if err != nil {
 			return err
 		}
+		update.tags, _ = cmd.Flags().GetStringArray("tag")
 
-		// Format as Details View
-		formatted, err := formatTaskDetailsView(updated)
+		if isBulk(cmd, args) {
+			targets, err := taskTargets(cmd, client, res, args)
+			if err != nil {
+				return err
+			}
+			return runBulk(cmd, res, targets, "updated", func(taskID string) error {
+				_, err := update.apply(client, taskID)
+				return err
+			})
+		}
+
+		taskID, err := res.ResolveTask(args[0])
 		if err != nil {
 			return err
 		}
 
-		fmt.Println(formatted)
-		return nil
+		updated, err := update.apply(client, taskID)
+		if err != nil {
+			return err
+		}
+
+		return printTaskResult(updated)
 	},
 }
 
+// taskUpdate is an update built from flags, applicable to any task. The
+// status and replaced assignees depend on the task, so they are worked
+// out when the update is applied.
+type taskUpdate struct {
+	req              api.UpdateTaskRequest
+	status           string
+	replaceAssignees bool
+	addAssignees     []string
+	removeAssignees  []string
+	tags             []string
+	customFields     []api.CustomFieldValue
+}
+
+// apply updates a task and returns it as updated.
+func (u taskUpdate) apply(client *api.Client, taskID string) (api.Task, error) {
+	req := u.req
+
+	var task api.Task
+	if u.status != "" || u.replaceAssignees {
+		var err error
+		task, err = api.GetTask(client, taskID)
+		if err != nil {
+			return api.Task{}, err
+		}
+	}
+
+	if u.replaceAssignees {
+		var removeIDs []string
+		for _, user := range task.Assignees {
+			removeIDs = append(removeIDs, user.ID)
+		}
+		req.Assignees = assigneesUpdate(task.Assignees, u.addAssignees, removeIDs)
+	} else if len(u.addAssignees) > 0 || len(u.removeAssignees) > 0 {
+		req.Assignees = assigneesUpdate(nil, u.addAssignees, u.removeAssignees)
+	}
+
+	if u.status != "" {
+		status, err := resolveStatus(client, task.ListID, u.status)
+		if err != nil {
+			return api.Task{}, err
+		}
+		req.Status = &status
+	}
+
+	// Tags and custom fields have their own endpoints
+	for _, tag := range u.tags {
+		if err := api.AddTaskTag(client, taskID, tag); err != nil {
+			return api.Task{}, fmt.Errorf("failed to add tag %q: %w", tag, err)
+		}
+	}
+	for _, field := range u.customFields {
+		if err := api.SetCustomField(client, taskID, field); err != nil {
+			return api.Task{}, fmt.Errorf("failed to set custom field %s: %w", field.ID, err)
+		}
+	}
+
+	if req.IsEmpty() {
+		return api.GetTask(client, taskID)
+	}
+	return api.UpdateTask(client, taskID, req)
+}
+
+// resolveUsers resolves each name, ID, or username to a user ID, skipping
+// blank names.
+func resolveUsers(res *resolver.Resolver, names []string) ([]string, error) {
+	ids := []string{}
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		id, err := res.ResolveUser(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve assignee %q: %w", name, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// assigneesUpdate builds the add/remove lists for an update. Users already
+// assigned are not added again, and users both added and removed stay
+// assigned, which is how replacing the assignees keeps the ones that remain.
+func assigneesUpdate(current []api.User, add, remove []string) *api.AssigneesUpdate {
+	assigned := make(map[string]bool)
+	for _, user := range current {
+		assigned[user.ID] = true
+	}
+
+	update := &api.AssigneesUpdate{Add: []string{}, Rem: []string{}}
+	for _, id := range add {
+		if !assigned[id] && !slices.Contains(update.Add, id) {
+			update.Add = append(update.Add, id)
+		}
+	}
+	for _, id := range remove {
+		if !slices.Contains(add, id) && !slices.Contains(update.Rem, id) {
+			update.Rem = append(update.Rem, id)
+		}
+	}
+	return update
+}
+
 func init() {
 	rootCmd.AddCommand(tasksCmd)
 	tasksCmd.AddCommand(tasksListCmd)
@@ -367,86 +864,135 @@ func init() {
 	tasksCmd.AddCommand(tasksUpdateCmd)
 	tasksListCmd.Flags().StringP("list", "l", "", "list name, ID, or URL")
 	tasksListCmd.Flags().BoolP("recursive", "r", false, "include subtasks")
+	tasksListCmd.Flags().Bool("archived", false, "list archived tasks instead of active ones")
+	tasksListCmd.Flags().String("fields", "", "comma-separated task fields to show, or \"all\" ("+strings.Join(taskFieldNames, ",")+")")
+	tasksListCmd.Flags().SetNormalizeFunc(fieldsAlias)
+	tasksShowCmd.Flags().Bool("raw", false, "print the description as raw markdown")
 	tasksCreateCmd.Flags().StringP("title", "t", "", "task title")
 	tasksCreateCmd.Flags().StringP("list", "l", "", "list name, ID, or URL")
-	tasksCreateCmd.Flags().StringP("description", "d", "", "task description")
-	tasksCreateCmd.Flags().IntP("priority", "p", 0, "task priority (1-5, 0=none)")
+	tasksCreateCmd.Flags().StringP("description", "d", "", "task description (\"-\" reads stdin, @file reads a file, @@ escapes a literal @)")
+	tasksCreateCmd.Flags().String("markdown-description", "", "task description in markdown (\"-\" reads stdin, @file reads a file, @@ escapes a literal @)")
+	tasksCreateCmd.MarkFlagsMutuallyExclusive("description", "markdown-description")
+	tasksCreateCmd.Flags().StringP("priority", "p", "", "task priority (urgent, high, normal, low, none, or 0-4)")
 	tasksCreateCmd.Flags().String("status", "", "task status")
-	tasksCreateCmd.Flags().String("due", "", "due date")
-	tasksCreateCmd.Flags().String("assignee", "", "assignee name, ID, or username")
+	tasksCreateCmd.Flags().String("due", "", "due date (YYYY-MM-DD or YYYY-MM-DD HH:MM)")
+	tasksCreateCmd.Flags().String("start", "", "start date (YYYY-MM-DD or YYYY-MM-DD HH:MM)")
+	tasksCreateCmd.Flags().String("estimate", "", "time estimate (e.g. 45m, 1h30m)")
+	tasksCreateCmd.Flags().Float64("points", 0, "sprint points")
+	tasksCreateCmd.Flags().StringArray("assignee", nil, "assignee name, ID, or username (repeatable)")
+	tasksCreateCmd.Flags().StringArray("tag", nil, "tag name (repeatable)")
+	tasksCreateCmd.Flags().StringArray("field", nil, "custom field value as FIELD_ID=VALUE (repeatable)")
 	tasksCreateCmd.Flags().String("parent", "", "parent task ID or name")
+	tasksCreateCmd.Flags().String("links-to", "", "task to link the new task to")
+	tasksCreateCmd.Flags().Bool("notify-all", false, "notify all assignees and watchers, including you")
 	tasksUpdateCmd.Flags().StringP("title", "t", "", "task title")
-	tasksUpdateCmd.Flags().StringP("assignee", "a", "", "assignee name, ID, or username")
+	tasksUpdateCmd.Flags().StringArrayP("assignee", "a", nil, "replace the assignees with this user (name, ID, or username; repeatable)")
+	tasksUpdateCmd.Flags().StringArray("add-assignee", nil, "add an assignee by name, ID, or username (repeatable)")
+	tasksUpdateCmd.Flags().StringArray("remove-assignee", nil, "remove an assignee by name, ID, or username (repeatable)")
+	tasksUpdateCmd.MarkFlagsMutuallyExclusive("assignee", "add-assignee")
+	tasksUpdateCmd.MarkFlagsMutuallyExclusive("assignee", "remove-assignee")
 	tasksUpdateCmd.Flags().StringP("status", "s", "", "task status")
-	tasksUpdateCmd.Flags().StringP("priority", "p", "", "task priority")
-	tasksUpdateCmd.Flags().StringP("description", "d", "", "task description (markdown)")
-	tasksUpdateCmd.Flags().String("due", "", "due date")
+	tasksUpdateCmd.Flags().StringP("priority", "p", "", "task priority (urgent, high, normal, low, or 1-4; none or 0 clears it)")
+	tasksUpdateCmd.Flags().StringP("description", "d", "", "task description (\"-\" reads stdin, @file reads a file, @@ escapes a literal @)")
+	tasksUpdateCmd.Flags().String("markdown-description", "", "task description in markdown (\"-\" reads stdin, @file reads a file, @@ escapes a literal @)")
+	tasksUpdateCmd.MarkFlagsMutuallyExclusive("description", "markdown-description")
+	tasksUpdateCmd.Flags().String("due", "", "due date (YYYY-MM-DD or YYYY-MM-DD HH:MM)")
+	tasksUpdateCmd.Flags().String("start", "", "start date (YYYY-MM-DD or YYYY-MM-DD HH:MM)")
+	tasksUpdateCmd.Flags().String("estimate", "", "time estimate (e.g. 45m, 1h30m)")
+	tasksUpdateCmd.Flags().Float64("points", 0, "sprint points")
+	tasksUpdateCmd.Flags().StringArray("tag", nil, "add a tag (repeatable)")
+	tasksUpdateCmd.Flags().StringArray("field", nil, "set a custom field as FIELD_ID=VALUE (repeatable)")
 	tasksUpdateCmd.Flags().String("parent", "", "parent task name, ID, or URL")
 
 	tasksCmd.AddCommand(tasksDeleteCmd)
 	tasksCmd.AddCommand(tasksArchiveCmd)
+	tasksCmd.AddCommand(tasksUnarchiveCmd)
+	addBulkFlags(tasksUpdateCmd)
+	addBulkFlags(tasksDeleteCmd)
+	addBulkFlags(tasksArchiveCmd)
+	tasksDeleteCmd.Flags().BoolP("yes", "y", false, "don't ask for confirmation")
+	tasksArchiveCmd.Flags().BoolP("yes", "y", false, "don't ask for confirmation")
+	tasksUnarchiveCmd.Flags().Bool("stdin", false, "read task IDs, names, or URLs from stdin, one per line")
 }
 
 var tasksDeleteCmd = &cobra.Command{
-	Use:   "delete <task-id|name|url>",
-	Short: "Delete a task permanently",
-	Args:  cobra.ExactArgs(1),
+	Use:   "delete [task-id|name|url...]",
+	Short: "Delete tasks permanently",
+	Long: `Delete one or more tasks permanently. Select tasks by argument, with
+--stdin, or with --list and --where-status.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		taskArg := args[0]
+		return runTaskAction(cmd, args, "Delete", "deleted", api.DeleteTask)
+	},
+}
 
-		kr := GetKeyring()
-		apiKey, err := kr.GetAPIKey()
-		if err != nil {
-			return err
-		}
+var tasksArchiveCmd = &cobra.Command{
+	Use:   "archive [task-id|name|url...]",
+	Short: "Archive tasks",
+	Long: `Archive one or more tasks. Select tasks by argument, with --stdin, or
+with --list and --where-status.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTaskAction(cmd, args, "Archive", "archived", api.ArchiveTask)
+	},
+}
 
-		cfg := GetConfig()
-		client := api.NewClient(apiKey, cfg.BaseURL, cfg.SpaceID)
-		res := resolver.New(client, cfg.StrictResolve)
+var tasksUnarchiveCmd = &cobra.Command{
+	Use:   "unarchive [task-id|name|url...]",
+	Short: "Restore archived tasks",
+	Long: `Restore one or more archived tasks. Select tasks by argument or with
+--stdin; find archived tasks with "tasks list --archived".`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTaskAction(cmd, args, "", "unarchived", api.UnarchiveTask)
+	},
+}
 
-		taskID, err := res.ResolveTask(taskArg)
-		if err != nil {
-			return err
-		}
+// runTaskAction runs action on the selected tasks, reporting each as done.
+// When prompt is set, the tasks are shown for confirmation first unless
+// --yes or --dry-run is set.
+func runTaskAction(cmd *cobra.Command, args []string, prompt, done string, action func(*api.Client, string) error) error {
+	kr := GetKeyring()
+	apiKey, err := kr.GetAPIKey()
+	if err != nil {
+		return err
+	}
+
+	cfg := GetConfig()
+	client := newClient(apiKey, cfg)
+	res := resolver.New(client, cfg.StrictResolve)
 
-		err = api.DeleteTask(client, taskID)
+	targets := args
+	if isBulk(cmd, args) {
+		targets, err = taskTargets(cmd, client, res, args)
 		if err != nil {
 			return err
 		}
+	}
 
-		fmt.Fprintf(cmd.OutOrStdout(), "Task %s deleted\n", taskID)
-		return nil
-	},
-}
-
-var tasksArchiveCmd = &cobra.Command{
-	Use:   "archive <task-id|name|url>",
-	Short: "Archive a task",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		taskArg := args[0]
-
-		kr := GetKeyring()
-		apiKey, err := kr.GetAPIKey()
+	if yes, _ := cmd.Flags().GetBool("yes"); prompt != "" && !yes && !dryRun {
+		confirmed, err := confirmTasks(cmd, client, res, targets, prompt)
 		if err != nil {
 			return err
 		}
+		if !confirmed {
+			return fmt.Errorf("aborted")
+		}
+	}
 
-		cfg := GetConfig()
-		client := api.NewClient(apiKey, cfg.BaseURL, cfg.SpaceID)
-		res := resolver.New(client, cfg.StrictResolve)
-
-		taskID, err := res.ResolveTask(taskArg)
+	if !isBulk(cmd, args) {
+		taskID, err := res.ResolveTask(args[0])
 		if err != nil {
 			return err
 		}
 
-		err = api.ArchiveTask(client, taskID)
+		err = action(client, taskID)
 		if err != nil {
 			return err
 		}
 
-		fmt.Fprintf(cmd.OutOrStdout(), "Task %s archived\n", taskID)
+		printResult(cmd.OutOrStdout(), "Task %s %s\n", taskID, done)
 		return nil
-	},
+	}
+
+	return runBulk(cmd, res, targets, done, func(taskID string) error {
+		return action(client, taskID)
+	})
 }
@@ -0,0 +1,417 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/config"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/keyring"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// capturedRequest is a request received by a payload test server.
+type capturedRequest struct {
+	Method string
+	Path   string
+	Body   map[string]any
+}
+
+// testListStatuses is served for every list by the payload test server.
+const testListStatuses = `{"id": "list456", "name": "Backlog", "statuses": [
+	{"status": "to do", "type": "open", "orderindex": 0},
+	{"status": "in progress", "type": "custom", "orderindex": 1},
+	{"status": "review", "type": "custom", "orderindex": 2},
+	{"status": "done", "type": "closed", "orderindex": 3}
+]}`
+
+// runWithServer points the commands at a test server that records every
+// request and answers with a bare task, then runs cmd with args.
+func runWithServer(t *testing.T, cmd *cobra.Command, args []string) ([]capturedRequest, error) {
+	t.Helper()
+	return runWithServerResponse(t, cmd, `{"id": "task123", "name": "Task", "list": "list456"}`, args)
+}
+
+// runWithServerResponse is runWithServer with a custom response body.
+func runWithServerResponse(t *testing.T, cmd *cobra.Command, response string, args []string) ([]capturedRequest, error) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		requests []capturedRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := capturedRequest{Method: r.Method, Path: r.URL.Path}
+		json.NewDecoder(r.Body).Decode(&req.Body)
+		mu.Lock()
+		requests = append(requests, req)
+		mu.Unlock()
+		if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/list/") && !strings.HasSuffix(r.URL.Path, "/task") {
+			w.Write([]byte(testListStatuses))
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	cfg = &config.Config{BaseURL: server.URL}
+	kr = keyring.New(&mockKeyringProvider{apiKey: "test-key"})
+	useFormatter(t, "json")
+
+	t.Cleanup(func() { resetFlags(cmd) })
+	if err := cmd.ParseFlags(args); err != nil {
+		return nil, err
+	}
+	if err := cmd.ValidateFlagGroups(); err != nil {
+		return nil, err
+	}
+	err := cmd.RunE(cmd, cmd.Flags().Args())
+	return requests, err
+}
+
+// useFormatter sets the output format until the test ends.
+func useFormatter(t *testing.T, format string) {
+	t.Helper()
+	previous := formatter
+	t.Cleanup(func() { formatter = previous })
+	formatter, _ = output.NewFormatter(format)
+}
+
+// useDryRun turns on --dry-run until the test ends.
+func useDryRun(t *testing.T) {
+	t.Helper()
+	previous := dryRun
+	t.Cleanup(func() { dryRun = previous })
+	dryRun = true
+}
+
+// mutations drops the GET requests made to look up tasks and statuses.
+func mutations(requests []capturedRequest) []capturedRequest {
+	var changes []capturedRequest
+	for _, req := range requests {
+		if req.Method != http.MethodGet {
+			changes = append(changes, req)
+		}
+	}
+	return changes
+}
+
+// resetFlags restores a command's flags to their defaults, since commands
+// are shared between tests.
+func resetFlags(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if slice, ok := f.Value.(pflag.SliceValue); ok {
+			slice.Replace(nil)
+		} else {
+			f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	})
+}
+
+func TestTasksCreatePayload(t *testing.T) {
+	requests, err := runWithServer(t, tasksCreateCmd, []string{
+		"--title", "Release",
+		"--list", "list456",
+		"--assignee", "1", "--assignee", "2",
+		"--tag", "release", "--tag", "v2",
+		"--start", "2025-01-15",
+		"--estimate", "2h",
+		"--points", "3",
+		"--notify-all",
+		"--links-to", "task9",
+		"--field", "field1=42",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+	req := requests[0]
+	if req.Method != http.MethodPost || req.Path != "/list/list456/task" {
+		t.Errorf("expected POST /list/list456/task, got %s %s", req.Method, req.Path)
+	}
+	body := req.Body
+	if assignees, _ := body["assignees"].([]any); len(assignees) != 2 {
+		t.Errorf("expected 2 assignees, got %v", body["assignees"])
+	}
+	if tags, _ := body["tags"].([]any); len(tags) != 2 {
+		t.Errorf("expected 2 tags, got %v", body["tags"])
+	}
+	start := float64(time.Date(2025, 1, 15, 0, 0, 0, 0, time.Local).UnixMilli())
+	if body["start_date"] != start {
+		t.Errorf("expected start_date %v, got %v", start, body["start_date"])
+	}
+	if body["time_estimate"] != float64(7200000) {
+		t.Errorf("expected time_estimate 7200000, got %v", body["time_estimate"])
+	}
+	if body["points"] != float64(3) || body["notify_all"] != true || body["links_to"] != "task9" {
+		t.Errorf("unexpected points/notify_all/links_to: %v", body)
+	}
+	fields, _ := body["custom_fields"].([]any)
+	if len(fields) != 1 || fields[0].(map[string]any)["value"] != float64(42) {
+		t.Errorf("expected custom field with numeric value, got %v", body["custom_fields"])
+	}
+}
+
+func TestTasksCreateRejectsInvalidValues(t *testing.T) {
+	for _, args := range [][]string{
+		{"--due", "tomorrow"},
+		{"--estimate", "a while"},
+		{"--field", "no-value"},
+	} {
+		requests, err := runWithServer(t, tasksCreateCmd, append([]string{"-t", "Task", "-l", "list456"}, args...))
+		if err == nil {
+			t.Errorf("expected error for %v", args)
+		}
+		if len(requests) != 0 {
+			t.Errorf("expected no requests for %v, got %v", args, requests)
+		}
+		resetFlags(tasksCreateCmd)
+	}
+}
+
+func TestTasksUpdatePayload(t *testing.T) {
+	requests, err := runWithServer(t, tasksUpdateCmd, []string{
+		"task123",
+		"--status", "REV",
+		"--priority", "0",
+		"--tag", "needs qa",
+		"--field", "field1=done",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests = mutations(requests)
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %v", requests)
+	}
+	if requests[0].Method != http.MethodPost || requests[0].Path != "/task/task123/tag/needs qa" {
+		t.Errorf("expected tag request first, got %s %s", requests[0].Method, requests[0].Path)
+	}
+	if requests[1].Path != "/task/task123/field/field1" || requests[1].Body["value"] != "done" {
+		t.Errorf("expected custom field request, got %+v", requests[1])
+	}
+	update := requests[2]
+	if update.Method != http.MethodPut || update.Path != "/task/task123" {
+		t.Errorf("expected PUT /task/task123, got %s %s", update.Method, update.Path)
+	}
+	if len(update.Body) != 2 || update.Body["status"] != "review" {
+		t.Errorf("expected only status and priority, got %v", update.Body)
+	}
+	if v, ok := update.Body["priority"]; !ok || v != nil {
+		t.Errorf("expected priority to be cleared, got %v", update.Body["priority"])
+	}
+}
+
+func TestTasksUpdateWithoutTaskFieldsFetchesTask(t *testing.T) {
+	requests, err := runWithServer(t, tasksUpdateCmd, []string{"task123", "--tag", "bug"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(requests) != 2 || requests[1].Method != http.MethodGet {
+		t.Errorf("expected tag request followed by GET, got %v", requests)
+	}
+}
+
+func assigneesBody(t *testing.T, body map[string]any) (add, rem []any) {
+	t.Helper()
+	assignees, ok := body["assignees"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected assignees object, got %v", body["assignees"])
+	}
+	add, _ = assignees["add"].([]any)
+	rem, _ = assignees["rem"].([]any)
+	return add, rem
+}
+
+func TestTasksUpdateAddRemoveAssignees(t *testing.T) {
+	requests, err := runWithServer(t, tasksUpdateCmd, []string{
+		"task123",
+		"--add-assignee", "1", "--add-assignee", "2",
+		"--remove-assignee", "3",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(requests) != 1 || requests[0].Method != http.MethodPut {
+		t.Fatalf("expected a single PUT, got %v", requests)
+	}
+	add, rem := assigneesBody(t, requests[0].Body)
+	if len(add) != 2 || add[0] != "1" || add[1] != "2" {
+		t.Errorf("expected add [1 2], got %v", add)
+	}
+	if len(rem) != 1 || rem[0] != "3" {
+		t.Errorf("expected rem [3], got %v", rem)
+	}
+	if _, ok := requests[0].Body["assignee"]; ok {
+		t.Error("expected no single assignee field")
+	}
+}
+
+func TestTasksUpdateReplaceAssignees(t *testing.T) {
+	current := `{"id": "task123", "assignees": [{"id": "1"}, {"id": "3"}]}`
+	requests, err := runWithServerResponse(t, tasksUpdateCmd, current, []string{
+		"task123", "--assignee", "1", "--assignee", "2",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(requests) != 2 || requests[0].Method != http.MethodGet || requests[1].Method != http.MethodPut {
+		t.Fatalf("expected GET then PUT, got %v", requests)
+	}
+	add, rem := assigneesBody(t, requests[1].Body)
+	if len(add) != 1 || add[0] != "2" {
+		t.Errorf("expected add [2], got %v", add)
+	}
+	if len(rem) != 1 || rem[0] != "3" {
+		t.Errorf("expected rem [3], got %v", rem)
+	}
+}
+
+func TestTasksUpdateClearAssignees(t *testing.T) {
+	current := `{"id": "task123", "assignees": [{"id": "1"}, {"id": "3"}]}`
+	requests, err := runWithServerResponse(t, tasksUpdateCmd, current, []string{"task123", "--assignee", ""})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	add, rem := assigneesBody(t, requests[len(requests)-1].Body)
+	if len(add) != 0 || len(rem) != 2 {
+		t.Errorf("expected every assignee removed, got add %v rem %v", add, rem)
+	}
+}
+
+func TestTasksUpdateAssigneeFlagsExclusive(t *testing.T) {
+	requests, err := runWithServer(t, tasksUpdateCmd, []string{"task123", "--assignee", "1", "--add-assignee", "2"})
+	if err == nil {
+		t.Error("expected error combining --assignee with --add-assignee")
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no requests, got %v", requests)
+	}
+}
+
+func TestTasksUpdateDescriptionFlagsExclusive(t *testing.T) {
+	requests, err := runWithServer(t, tasksUpdateCmd, []string{"task123", "-d", "-", "--markdown-description", "-"})
+	if err == nil {
+		t.Error("expected error combining --description with --markdown-description")
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no requests, got %v", requests)
+	}
+}
+
+func TestTasksCreateDescriptionFlagsExclusive(t *testing.T) {
+	requests, err := runWithServer(t, tasksCreateCmd, []string{"--title", "Task", "--list", "list456", "-d", "plain", "--markdown-description", "**bold**"})
+	if err == nil {
+		t.Error("expected error combining --description with --markdown-description")
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no requests, got %v", requests)
+	}
+}
+
+func TestAssigneesUpdate(t *testing.T) {
+	current := []api.User{{ID: "1"}, {ID: "2"}}
+
+	update := assigneesUpdate(current, []string{"2", "3", "3"}, []string{"1", "2"})
+
+	if len(update.Add) != 1 || update.Add[0] != "3" {
+		t.Errorf("expected add [3], got %v", update.Add)
+	}
+	if len(update.Rem) != 1 || update.Rem[0] != "1" {
+		t.Errorf("expected rem [1], got %v", update.Rem)
+	}
+}
+
+func TestTasksCreatePriorityName(t *testing.T) {
+	requests, err := runWithServer(t, tasksCreateCmd, []string{"-t", "Task", "-l", "list456", "--priority", "High"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if requests[0].Body["priority"] != float64(api.PriorityHigh) {
+		t.Errorf("expected priority %d, got %v", api.PriorityHigh, requests[0].Body["priority"])
+	}
+}
+
+func TestTasksUpdatePriorityNoneClears(t *testing.T) {
+	requests, err := runWithServer(t, tasksUpdateCmd, []string{"task123", "--priority", "none"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if v, ok := requests[0].Body["priority"]; !ok || v != nil {
+		t.Errorf("expected priority to be cleared, got %v", requests[0].Body)
+	}
+}
+
+func TestInvalidPriorityMakesNoRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func() ([]capturedRequest, error)
+	}{
+		{"create", func() ([]capturedRequest, error) {
+			return runWithServer(t, tasksCreateCmd, []string{"-t", "Task", "-l", "Backlog", "-p", "critical"})
+		}},
+		{"update", func() ([]capturedRequest, error) {
+			return runWithServer(t, tasksUpdateCmd, []string{"Fix login bug", "-p", "5"})
+		}},
+	}
+	for _, tt := range tests {
+		requests, err := tt.run()
+		if err == nil || !strings.Contains(err.Error(), "invalid priority") {
+			t.Errorf("%s: expected invalid priority error, got %v", tt.name, err)
+		}
+		if len(requests) != 0 {
+			t.Errorf("%s: expected no requests, got %v", tt.name, requests)
+		}
+	}
+}
+
+func TestTasksCreateMatchesStatus(t *testing.T) {
+	requests, err := runWithServer(t, tasksCreateCmd, []string{"-t", "Task", "-l", "list456", "--status", "In Prog"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	created := mutations(requests)
+	if len(created) != 1 || created[0].Body["status"] != "in progress" {
+		t.Errorf("expected status 'in progress', got %v", created)
+	}
+}
+
+func TestTasksInvalidStatusMakesNoChanges(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  *cobra.Command
+		args []string
+	}{
+		{"create", tasksCreateCmd, []string{"-t", "Task", "-l", "list456", "--status", "in progres s"}},
+		{"update", tasksUpdateCmd, []string{"task123", "--status", "shipped"}},
+	}
+	for _, tt := range tests {
+		requests, err := runWithServer(t, tt.cmd, tt.args)
+		if err == nil || !strings.Contains(err.Error(), "valid statuses: to do, in progress, review, done") {
+			t.Errorf("%s: expected error listing valid statuses, got %v", tt.name, err)
+		}
+		if changes := mutations(requests); len(changes) != 0 {
+			t.Errorf("%s: expected no changes, got %v", tt.name, changes)
+		}
+		resetFlags(tt.cmd)
+	}
+}
@@ -1,11 +1,13 @@
 package cmd
 
 import (
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
 	"github.com/Fire-Dragon-DoL/clickup-cli/internal/config"
-	"github.com/Fire-Dragon-DoL/clickup-cli/internal/output"
 )
 
 func TestTasksCmd(t *testing.T) {
@@ -56,6 +58,12 @@ func TestTasksListCmdHasRecursiveFlag(t *testing.T) {
 	}
 }
 
+func TestTasksListCmdHasArchivedFlag(t *testing.T) {
+	if tasksListCmd.Flags().Lookup("archived") == nil {
+		t.Error("expected 'archived' flag to exist")
+	}
+}
+
 func TestFormatTasksListView(t *testing.T) {
 	tasks := []api.Task{
 		{
@@ -87,7 +95,7 @@ func TestFormatTasksListView(t *testing.T) {
 	}
 
 	cfg = &config.Config{OutputFormat: "text"}
-	formatter = output.NewFormatter("text")
+	useFormatter(t, "text")
 
 	formatted, err := formatTasksListView(tasks)
 
@@ -99,6 +107,31 @@ func TestFormatTasksListView(t *testing.T) {
 	}
 }
 
+func TestFormatTasksListViewTemplateComparesStatus(t *testing.T) {
+	tasks := []api.Task{{ID: "task1"}}
+	tasks[0].Status = &struct {
+		ID      string `json:"id"`
+		Status  string `json:"status"`
+		Color   string `json:"color"`
+		OrderBy int    `json:"orderby"`
+	}{Status: "open", Color: "#ff0000"}
+
+	useFormatter(t, "template")
+	formatter.SetColor(true)
+	if err := formatter.SetTemplate(`{{if eq .Status "open"}}{{.ID}}{{end}}`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	formatted, err := formatTasksListView(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if formatted != "task1" {
+		t.Errorf("expected the open task, got %q", formatted)
+	}
+}
+
 func TestFormatTasksListViewWithNilFields(t *testing.T) {
 	tasks := []api.Task{
 		{
@@ -111,7 +144,7 @@ func TestFormatTasksListViewWithNilFields(t *testing.T) {
 	}
 
 	cfg = &config.Config{OutputFormat: "text"}
-	formatter = output.NewFormatter("text")
+	useFormatter(t, "text")
 
 	formatted, err := formatTasksListView(tasks)
 
@@ -178,7 +211,7 @@ func TestFormatTaskDetailsView(t *testing.T) {
 	}
 
 	cfg = &config.Config{OutputFormat: "text"}
-	formatter = output.NewFormatter("text")
+	useFormatter(t, "text")
 
 	formatted, err := formatTaskDetailsView(task, comments...)
 
@@ -197,7 +230,7 @@ func TestFormatTaskDetailsViewWithoutComments(t *testing.T) {
 	}
 
 	cfg = &config.Config{OutputFormat: "text"}
-	formatter = output.NewFormatter("text")
+	useFormatter(t, "text")
 
 	formatted, err := formatTaskDetailsView(task)
 
@@ -215,8 +248,8 @@ func TestTasksDeleteCmd(t *testing.T) {
 	if cmd == nil {
 		t.Fatal("tasksDeleteCmd is nil")
 	}
-	if cmd.Use != "delete <task-id|name|url>" {
-		t.Errorf("expected Use 'delete <task-id|name|url>', got '%s'", cmd.Use)
+	if cmd.Use != "delete [task-id|name|url...]" {
+		t.Errorf("expected Use 'delete [task-id|name|url...]', got '%s'", cmd.Use)
 	}
 	if cmd.Short == "" {
 		t.Error("expected non-empty Short description")
@@ -229,10 +262,142 @@ func TestTasksArchiveCmd(t *testing.T) {
 	if cmd == nil {
 		t.Fatal("tasksArchiveCmd is nil")
 	}
-	if cmd.Use != "archive <task-id|name|url>" {
-		t.Errorf("expected Use 'archive <task-id|name|url>', got '%s'", cmd.Use)
+	if cmd.Use != "archive [task-id|name|url...]" {
+		t.Errorf("expected Use 'archive [task-id|name|url...]', got '%s'", cmd.Use)
 	}
 	if cmd.Short == "" {
 		t.Error("expected non-empty Short description")
 	}
 }
+
+func TestBuildTasksListViewNestsSubtasks(t *testing.T) {
+	tasks := []api.Task{
+		{ID: "child", Name: "Child", ParentID: "parent"},
+		{ID: "parent", Name: "Parent"},
+		{ID: "other", Name: "Other", ParentID: "elsewhere"},
+	}
+
+	views := buildTasksListView(tasks)
+
+	if len(views) != 3 {
+		t.Fatalf("expected 3 views, got %d", len(views))
+	}
+	if views[0].ID != "parent" || views[0].IndentLevel() != 0 {
+		t.Errorf("expected root 'parent' first, got %q at level %d", views[0].ID, views[0].IndentLevel())
+	}
+	if views[1].ID != "child" || views[1].IndentLevel() != 1 {
+		t.Errorf("expected 'child' nested under parent, got %q at level %d", views[1].ID, views[1].IndentLevel())
+	}
+	if views[2].ID != "other" || views[2].IndentLevel() != 0 {
+		t.Errorf("subtask with unknown parent should be a root, got %q at level %d", views[2].ID, views[2].IndentLevel())
+	}
+}
+
+func TestDueDateColorOverdue(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	past := strconv.FormatInt(now.Add(-time.Hour).UnixMilli(), 10)
+	future := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
+
+	tests := []struct {
+		name     string
+		task     api.Task
+		expected string
+	}{
+		{"overdue", api.Task{DueDate: past}, "red"},
+		{"upcoming", api.Task{DueDate: future}, ""},
+		{"closed", api.Task{DueDate: past, DateClosed: past}, ""},
+		{"no due date", api.Task{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			color := dueDateColor(tt.task, now)
+
+			if color != tt.expected {
+				t.Errorf("expected color %q, got %q", tt.expected, color)
+			}
+		})
+	}
+}
+
+func TestRenderTaskDetailsViewSections(t *testing.T) {
+	task := api.Task{
+		ID:                  "task123",
+		Name:                "Test Task",
+		Description:         "Plain description",
+		MarkdownDescription: "## Steps\n- **first**",
+	}
+	comments := []api.Comment{
+		{TextContent: "Looks good", User: api.User{Username: "john"}},
+	}
+
+	cfg = &config.Config{OutputFormat: "text"}
+	useFormatter(t, "text")
+
+	formatted, err := renderTaskDetailsView(task, comments, false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"Title:     Test Task", "Description\n", "Steps\n-----\n  • first", "Comments (1)", "john · \n  Looks good"} {
+		if !strings.Contains(formatted, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, formatted)
+		}
+	}
+}
+
+func TestRenderTaskDetailsViewRaw(t *testing.T) {
+	task := api.Task{ID: "task123", MarkdownDescription: "## Steps\n- **first**"}
+
+	cfg = &config.Config{OutputFormat: "text"}
+	useFormatter(t, "text")
+
+	formatted, err := renderTaskDetailsView(task, nil, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(formatted, "## Steps\n- **first**") {
+		t.Errorf("expected raw markdown, got:\n%s", formatted)
+	}
+}
+
+func TestNestTaskTree(t *testing.T) {
+	tasks := []api.Task{
+		{ID: "grandchild", ParentID: "child"},
+		{ID: "parent"},
+		{ID: "child", ParentID: "parent"},
+	}
+
+	nested := nestTaskTree(tasks)
+
+	if len(nested) != 1 || nested[0].ID != "parent" {
+		t.Fatalf("expected a single root 'parent', got %+v", nested)
+	}
+	if len(nested[0].Subtasks) != 1 || nested[0].Subtasks[0].ID != "child" {
+		t.Fatalf("expected 'child' under parent, got %+v", nested[0].Subtasks)
+	}
+	if len(nested[0].Subtasks[0].Subtasks) != 1 || nested[0].Subtasks[0].Subtasks[0].ID != "grandchild" {
+		t.Errorf("expected 'grandchild' under child, got %+v", nested[0].Subtasks[0].Subtasks)
+	}
+}
+
+func TestWriteFormattedSkipsEmptyNDJSON(t *testing.T) {
+	useFormatter(t, "ndjson")
+	var out strings.Builder
+
+	formatted, err := formatTasksListView([]api.Task{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	writeFormatted(&out, formatted)
+
+	if out.Len() != 0 {
+		t.Errorf("expected no output for an empty page, got %q", out.String())
+	}
+
+	writeFormatted(&out, `{"ID":"task1"}`)
+	if out.String() != "{\"ID\":\"task1\"}\n" {
+		t.Errorf("expected one record per line, got %q", out.String())
+	}
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/resolver"
+	"github.com/spf13/cobra"
+)
+
+// Status transitions. start, done, and reopen can be mapped to a status per
+// list in the config file; next and prev always follow the list's order.
+const (
+	transitionStart  = "start"
+	transitionDone   = "done"
+	transitionReopen = "reopen"
+	transitionNext   = "next"
+	transitionPrev   = "prev"
+)
+
+var tasksStartCmd = newTransitionCmd(transitionStart, "Move a task to the first in-progress status")
+var tasksDoneCmd = newTransitionCmd(transitionDone, "Move a task to the list's done status")
+var tasksReopenCmd = newTransitionCmd(transitionReopen, "Move a task back to the list's open status")
+
+var tasksMoveStatusCmd = &cobra.Command{
+	Use:       "move-status <task-id|name|url> next|prev",
+	Short:     "Move a task to the next or previous status",
+	Args:      cobra.ExactArgs(2),
+	ValidArgs: []string{transitionNext, transitionPrev},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		direction := strings.ToLower(args[1])
+		if direction != transitionNext && direction != transitionPrev {
+			return fmt.Errorf("invalid direction %q (expected next or prev)", args[1])
+		}
+		return runTransition(cmd, args[0], direction)
+	},
+}
+
+func newTransitionCmd(transition, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:   transition + " <task-id|name|url>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTransition(cmd, args[0], transition)
+		},
+	}
+}
+
+func runTransition(cmd *cobra.Command, taskArg, transition string) error {
+	kr := GetKeyring()
+	apiKey, err := kr.GetAPIKey()
+	if err != nil {
+		return err
+	}
+
+	cfg := GetConfig()
+	client := newClient(apiKey, cfg)
+	res := resolver.New(client, cfg.StrictResolve)
+
+	taskID, err := res.ResolveTask(taskArg)
+	if err != nil {
+		return err
+	}
+
+	task, err := api.GetTask(client, taskID)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := api.GetListStatuses(client, task.ListID)
+	if err != nil {
+		return err
+	}
+
+	current := ""
+	if task.Status != nil {
+		current = task.Status.Status
+	}
+
+	target, err := transitionTarget(statuses, current, transition, cfg.StatusTransition(task.ListID, transition))
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(target, current) {
+		fmt.Fprintf(cmd.OutOrStdout(), "Task %s is already %s\n", taskID, current)
+		return nil
+	}
+
+	_, err = api.UpdateTask(client, taskID, api.UpdateTaskRequest{Status: &target})
+	if err != nil {
+		return err
+	}
+
+	printResult(cmd.OutOrStdout(), "Task %s: %s → %s\n", taskID, current, target)
+	return nil
+}
+
+// transitionTarget picks the status a transition moves a task to from the
+// list's statuses in workflow order. A configured status takes precedence
+// for start, done, and reopen.
+func transitionTarget(statuses []api.Status, current, transition, configured string) (string, error) {
+	if len(statuses) == 0 {
+		return "", fmt.Errorf("list has no statuses")
+	}
+	if configured != "" && transition != transitionNext && transition != transitionPrev {
+		status, err := api.MatchStatus(statuses, configured)
+		if err != nil {
+			return "", fmt.Errorf("configured %s status: %w", transition, err)
+		}
+		return status.Status, nil
+	}
+
+	index := -1
+	for i, status := range statuses {
+		if strings.EqualFold(status.Status, current) {
+			index = i
+		}
+	}
+
+	switch transition {
+	case transitionStart:
+		for _, status := range statuses {
+			if status.Type == "custom" {
+				return status.Status, nil
+			}
+		}
+		return transitionTarget(statuses, current, transitionNext, "")
+	case transitionDone:
+		for _, statusType := range []string{"done", "closed"} {
+			for _, status := range statuses {
+				if status.Type == statusType {
+					return status.Status, nil
+				}
+			}
+		}
+		return statuses[len(statuses)-1].Status, nil
+	case transitionReopen:
+		for _, status := range statuses {
+			if status.Type == "open" {
+				return status.Status, nil
+			}
+		}
+		return statuses[0].Status, nil
+	case transitionNext:
+		if index < 0 {
+			return "", fmt.Errorf("current status %q is not one of the list's statuses", current)
+		}
+		if index == len(statuses)-1 {
+			return "", fmt.Errorf("task is already in the last status (%s)", current)
+		}
+		return statuses[index+1].Status, nil
+	case transitionPrev:
+		if index < 0 {
+			return "", fmt.Errorf("current status %q is not one of the list's statuses", current)
+		}
+		if index == 0 {
+			return "", fmt.Errorf("task is already in the first status (%s)", current)
+		}
+		return statuses[index-1].Status, nil
+	}
+	return "", fmt.Errorf("unknown transition %q", transition)
+}
+
+func init() {
+	tasksCmd.AddCommand(tasksStartCmd)
+	tasksCmd.AddCommand(tasksDoneCmd)
+	tasksCmd.AddCommand(tasksReopenCmd)
+	tasksCmd.AddCommand(tasksMoveStatusCmd)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+var workflowStatuses = []api.Status{
+	{Status: "to do", Type: "open"},
+	{Status: "in progress", Type: "custom"},
+	{Status: "review", Type: "custom"},
+	{Status: "complete", Type: "done"},
+	{Status: "closed", Type: "closed"},
+}
+
+func TestTransitionCommands(t *testing.T) {
+	for _, name := range []string{"start", "done", "reopen", "move-status"} {
+		found := false
+		for _, sub := range tasksCmd.Commands() {
+			if sub.Name() == name {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected tasks subcommand %q", name)
+		}
+	}
+}
+
+func TestTransitionTarget(t *testing.T) {
+	tests := []struct {
+		transition string
+		current    string
+		want       string
+	}{
+		{transitionStart, "to do", "in progress"},
+		{transitionDone, "review", "complete"},
+		{transitionReopen, "closed", "to do"},
+		{transitionNext, "In Progress", "review"},
+		{transitionPrev, "review", "in progress"},
+	}
+	for _, tt := range tests {
+		got, err := transitionTarget(workflowStatuses, tt.current, tt.transition, "")
+		if err != nil {
+			t.Errorf("%s from %q: unexpected error: %v", tt.transition, tt.current, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s from %q: expected %q, got %q", tt.transition, tt.current, tt.want, got)
+		}
+	}
+}
+
+func TestTransitionTargetConfigured(t *testing.T) {
+	got, err := transitionTarget(workflowStatuses, "review", transitionDone, "Closed")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "closed" {
+		t.Errorf("expected configured status 'closed', got %q", got)
+	}
+
+	if _, err := transitionTarget(workflowStatuses, "review", transitionDone, "shipped"); err == nil {
+		t.Error("expected error for a configured status missing from the list")
+	}
+}
+
+func TestTransitionTargetEnds(t *testing.T) {
+	if _, err := transitionTarget(workflowStatuses, "closed", transitionNext, ""); err == nil {
+		t.Error("expected error moving past the last status")
+	}
+	if _, err := transitionTarget(workflowStatuses, "to do", transitionPrev, ""); err == nil {
+		t.Error("expected error moving before the first status")
+	}
+	if _, err := transitionTarget(workflowStatuses, "archived", transitionNext, ""); err == nil {
+		t.Error("expected error for an unknown current status")
+	}
+}
+
+func TestTasksMoveStatusNext(t *testing.T) {
+	task := `{"id": "task123", "list": "list456", "status": {"status": "in progress"}}`
+	requests, err := runWithServerResponse(t, tasksMoveStatusCmd, task, []string{"task123", "next"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changes := mutations(requests)
+	if len(changes) != 1 || changes[0].Method != http.MethodPut || changes[0].Body["status"] != "review" {
+		t.Errorf("expected status update to 'review', got %v", changes)
+	}
+}
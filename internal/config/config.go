@@ -1,14 +1,21 @@
 package config
 
 import (
+	"strings"
+
 	"github.com/spf13/viper"
 )
 
 type Config struct {
 	SpaceID       string `mapstructure:"space_id"`
+	WorkspaceID   string `mapstructure:"workspace_id"`
 	OutputFormat  string `mapstructure:"output_format"`
 	StrictResolve bool   `mapstructure:"strict_resolve"`
 	BaseURL       string `mapstructure:"base_url"`
+
+	// StatusTransitions maps a list ID to the statuses that the start,
+	// done, and reopen commands move its tasks to.
+	StatusTransitions map[string]map[string]string `mapstructure:"status_transitions"`
 }
 
 func newViper() *viper.Viper {
@@ -17,6 +24,7 @@ func newViper() *viper.Viper {
 	v.SetDefault("base_url", "https://api.clickup.com/api/v2")
 
 	v.BindEnv("space_id", "CLICKUP_SPACE_ID")
+	v.BindEnv("workspace_id", "CLICKUP_WORKSPACE_ID")
 	v.BindEnv("output_format", "CLICKUP_OUTPUT_FORMAT")
 	v.BindEnv("strict_resolve", "CLICKUP_STRICT_RESOLVE")
 	v.BindEnv("base_url", "CLICKUP_BASE_URL")
@@ -54,3 +62,9 @@ func (c *Config) ApplyCLIOverrides(spaceID, outputFormat string, strictResolve b
 		c.StrictResolve = true
 	}
 }
+
+// StatusTransition returns the status configured for a transition on a
+// list, or "" when none is configured.
+func (c *Config) StatusTransition(listID, transition string) string {
+	return c.StatusTransitions[strings.ToLower(listID)][strings.ToLower(transition)]
+}
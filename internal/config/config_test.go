@@ -114,3 +114,24 @@ func TestLoadConfig_PartialCLIOverrides(t *testing.T) {
 		t.Errorf("expected output format 'json' from CLI, got %q", cfg.OutputFormat)
 	}
 }
+
+func TestLoadConfig_StatusTransitions(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	configContent := `{"status_transitions": {"901ABC": {"Done": "Shipped", "start": "In Development"}}}`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := LoadFromFile(configPath)
+
+	if got := cfg.StatusTransition("901ABC", "done"); got != "Shipped" {
+		t.Errorf("expected done transition 'Shipped', got %q", got)
+	}
+	if got := cfg.StatusTransition("901abc", "start"); got != "In Development" {
+		t.Errorf("expected start transition 'In Development', got %q", got)
+	}
+	if got := cfg.StatusTransition("other", "done"); got != "" {
+		t.Errorf("expected no transition for an unconfigured list, got %q", got)
+	}
+}
@@ -0,0 +1,360 @@
+// Package export writes the folders, lists, tasks, and comments of a
+// space, folder, or list to a directory of JSON files.
+//
+// The directory holds a manifest.json describing the hierarchy and a file
+// per list under lists/. Everything is sorted by ID and indented the same
+// way on every run, so an unchanged list produces an identical file and
+// exports diff cleanly.
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+// Version is the version of the export format. It changes when files
+// written by an older version can no longer be read the same way.
+const Version = 1
+
+// Scope types.
+const (
+	ScopeSpace  = "space"
+	ScopeFolder = "folder"
+	ScopeList   = "list"
+)
+
+// Scope is what an export covers.
+type Scope struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Manifest describes an export. Lists outside any folder are in Lists.
+type Manifest struct {
+	Version int       `json:"version"`
+	Scope   Scope     `json:"scope"`
+	Folders []Folder  `json:"folders"`
+	Lists   []ListRef `json:"lists"`
+}
+
+type Folder struct {
+	ID    string    `json:"id"`
+	Name  string    `json:"name"`
+	Lists []ListRef `json:"lists"`
+}
+
+// ListRef names a list and the file holding its tasks, relative to the
+// export directory.
+type ListRef struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	File  string `json:"file"`
+	Tasks int    `json:"tasks"`
+}
+
+// ListFile holds the tasks of one list. Subtasks are listed alongside
+// their parent and point to it with their parent field.
+type ListFile struct {
+	Version int      `json:"version"`
+	List    api.List `json:"list"`
+	Tasks   []Task   `json:"tasks"`
+}
+
+// Task is a task with its comments. Archived tasks are exported too.
+type Task struct {
+	api.Task
+	Archived bool          `json:"archived"`
+	Comments []api.Comment `json:"comments"`
+}
+
+// Exporter exports to Dir, making at most Concurrency requests at a time.
+type Exporter struct {
+	Client      *api.Client
+	Dir         string
+	Concurrency int
+
+	// Incremental only fetches tasks updated since the list's previous
+	// export, merging them into it. Deleted tasks are kept until the next
+	// full export.
+	Incremental bool
+
+	// Log receives a line per list.
+	Log io.Writer
+
+	sem chan struct{}
+}
+
+// Run exports scope and returns the manifest written.
+func (e *Exporter) Run(scope Scope) (*Manifest, error) {
+	if e.Concurrency < 1 {
+		e.Concurrency = 1
+	}
+	if e.Log == nil {
+		e.Log = io.Discard
+	}
+	e.sem = make(chan struct{}, e.Concurrency)
+
+	manifest := &Manifest{
+		Version: Version,
+		Scope:   scope,
+		Folders: []Folder{},
+		Lists:   []ListRef{},
+	}
+	if err := e.walk(manifest); err != nil {
+		return nil, err
+	}
+
+	var refs []*ListRef
+	for i := range manifest.Folders {
+		for j := range manifest.Folders[i].Lists {
+			refs = append(refs, &manifest.Folders[i].Lists[j])
+		}
+	}
+	for i := range manifest.Lists {
+		refs = append(refs, &manifest.Lists[i])
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	for _, ref := range refs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := e.exportList(ref); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("list %s: %w", ref.ID, err)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := writeJSON(filepath.Join(e.Dir, "manifest.json"), manifest); err != nil {
+		return nil, err
+	}
+	if err := e.removeStaleLists(refs); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// removeStaleLists deletes the files of lists a previous export wrote that
+// are no longer in refs, such as deleted lists, so that the directory only
+// holds what the manifest describes.
+func (e *Exporter) removeStaleLists(refs []*ListRef) error {
+	exported := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		exported[ref.File] = true
+	}
+
+	entries, err := os.ReadDir(filepath.Join(e.Dir, "lists"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		file := "lists/" + entry.Name()
+		if entry.IsDir() || filepath.Ext(file) != ".json" || exported[file] {
+			continue
+		}
+		if err := os.Remove(filepath.Join(e.Dir, filepath.FromSlash(file))); err != nil {
+			return err
+		}
+		fmt.Fprintf(e.Log, "removed %s, which is no longer exported\n", file)
+	}
+	return nil
+}
+
+// walk fills in the folders and lists of the manifest's scope.
+func (e *Exporter) walk(manifest *Manifest) error {
+	c := e.Client
+	id := manifest.Scope.ID
+
+	switch manifest.Scope.Type {
+	case ScopeSpace:
+		folders, err := api.GetFolders(c, id)
+		if err != nil {
+			return err
+		}
+		for _, folder := range folders {
+			lists, err := api.GetLists(c, folder.ID)
+			if err != nil {
+				return err
+			}
+			manifest.Folders = append(manifest.Folders, Folder{ID: folder.ID, Name: folder.Name, Lists: listRefs(lists)})
+		}
+		lists, err := api.GetFolderlessLists(c, id)
+		if err != nil {
+			return err
+		}
+		manifest.Lists = listRefs(lists)
+	case ScopeFolder:
+		folder, err := api.GetFolder(c, id)
+		if err != nil {
+			return err
+		}
+		lists, err := api.GetLists(c, id)
+		if err != nil {
+			return err
+		}
+		manifest.Folders = append(manifest.Folders, Folder{ID: folder.ID, Name: folder.Name, Lists: listRefs(lists)})
+	case ScopeList:
+		list, err := api.GetList(c, id)
+		if err != nil {
+			return err
+		}
+		manifest.Lists = listRefs([]api.List{list})
+	default:
+		return fmt.Errorf("unknown scope %q", manifest.Scope.Type)
+	}
+
+	slices.SortFunc(manifest.Folders, func(a, b Folder) int { return strings.Compare(a.ID, b.ID) })
+	return nil
+}
+
+func listRefs(lists []api.List) []ListRef {
+	refs := make([]ListRef, 0, len(lists))
+	for _, list := range lists {
+		refs = append(refs, ListRef{ID: list.ID, Name: list.Name, File: "lists/" + list.ID + ".json"})
+	}
+	slices.SortFunc(refs, func(a, b ListRef) int { return strings.Compare(a.ID, b.ID) })
+	return refs
+}
+
+// exportList fetches a list's tasks and their comments and writes the
+// list's file. ClickUp lists archived tasks separately, so they are
+// fetched in a second pass.
+func (e *Exporter) exportList(ref *ListRef) error {
+	file := filepath.Join(e.Dir, filepath.FromSlash(ref.File))
+
+	var previous []Task
+	opts := api.TaskListOptions{Subtasks: true, IncludeClosed: true}
+	if e.Incremental {
+		old, err := readListFile(file)
+		if err != nil {
+			return err
+		}
+		if old != nil {
+			previous = old.Tasks
+			opts.UpdatedAfter = lastUpdated(previous)
+		}
+	}
+
+	var updated []Task
+	for _, archived := range []bool{false, true} {
+		opts.Archived = archived
+		var fetched []api.Task
+		err := e.limit(func() error {
+			return api.EachTaskPage(e.Client, ref.ID, opts, func(tasks []api.Task) error {
+				fetched = append(fetched, tasks...)
+				return nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		withComments, err := e.withComments(fetched, archived)
+		if err != nil {
+			return err
+		}
+		updated = append(updated, withComments...)
+	}
+	tasks := merge(previous, updated)
+	ref.Tasks = len(tasks)
+
+	fmt.Fprintf(e.Log, "%s (%s): %d tasks, %d fetched\n", ref.Name, ref.ID, len(tasks), len(updated))
+	return writeJSON(file, ListFile{
+		Version: Version,
+		List:    api.List{ID: ref.ID, Name: ref.Name},
+		Tasks:   tasks,
+	})
+}
+
+// withComments fetches the comments of each task.
+func (e *Exporter) withComments(tasks []api.Task, archived bool) ([]Task, error) {
+	result := make([]Task, len(tasks))
+	errs := make([]error, len(tasks))
+
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = e.limit(func() error {
+				comments, err := api.GetAllTaskComments(e.Client, task.ID)
+				if comments == nil {
+					comments = []api.Comment{}
+				}
+				task.Subtasks = nil
+				result[i] = Task{Task: task, Archived: archived, Comments: comments}
+				return err
+			})
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("comments of task %s: %w", tasks[i].ID, err)
+		}
+	}
+	return result, nil
+}
+
+// limit runs fn once fewer than Concurrency calls are running.
+func (e *Exporter) limit(fn func() error) error {
+	e.sem <- struct{}{}
+	defer func() { <-e.sem }()
+	return fn()
+}
+
+// lastUpdated returns the latest update time of tasks, in milliseconds.
+func lastUpdated(tasks []Task) int64 {
+	var last int64
+	for _, task := range tasks {
+		if updated, err := strconv.ParseInt(task.DateUpdated, 10, 64); err == nil && updated > last {
+			last = updated
+		}
+	}
+	return last
+}
+
+// merge replaces previous tasks with their updated versions, adds new
+// ones, and sorts the result by ID.
+func merge(previous, updated []Task) []Task {
+	byID := make(map[string]Task, len(previous)+len(updated))
+	for _, task := range previous {
+		byID[task.ID] = task
+	}
+	for _, task := range updated {
+		byID[task.ID] = task
+	}
+
+	tasks := make([]Task, 0, len(byID))
+	for _, task := range byID {
+		tasks = append(tasks, task)
+	}
+	slices.SortFunc(tasks, func(a, b Task) int { return strings.Compare(a.ID, b.ID) })
+	return tasks
+}
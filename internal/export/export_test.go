@@ -0,0 +1,198 @@
+package export
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+// testServer serves a space with two folders and a folderless list. The
+// tasks of list l1 are served from tasks, its archived tasks from
+// archived, and every query for them is recorded.
+type testServer struct {
+	mu       sync.Mutex
+	tasks    string
+	archived string
+	queries  []string
+}
+
+func (s *testServer) start(t *testing.T) *api.Client {
+	t.Helper()
+	responses := map[string]string{
+		"/space/s1/folder": `{"folders": [{"id": "f2", "name": "Ops"}, {"id": "f1", "name": "Engineering"}]}`,
+		"/space/s1/list":   `{"lists": [{"id": "l9", "name": "Inbox"}]}`,
+		"/folder/f1/list":  `{"lists": [{"id": "l1", "name": "Backlog"}]}`,
+		"/folder/f2/list":  `{"lists": []}`,
+		"/list/l9/task":    `{"tasks": [], "last_page": true}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/list/l1/task" {
+			s.mu.Lock()
+			s.queries = append(s.queries, r.URL.RawQuery)
+			tasks := s.tasks
+			if r.URL.Query().Get("archived") == "true" {
+				tasks = s.archived
+			}
+			s.mu.Unlock()
+			if tasks == "" {
+				tasks = `{"tasks": [], "last_page": true}`
+			}
+			w.Write([]byte(tasks))
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/comment") {
+			w.Write([]byte(`{"comments": [{"id": "c1", "comment_text": "", "text_content": "LGTM"}]}`))
+			return
+		}
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return api.NewClient("key", server.URL, "")
+}
+
+func TestExportSpace(t *testing.T) {
+	s := &testServer{tasks: `{"last_page": true, "tasks": [
+		{"id": "t2", "name": "Child", "parent": "t1", "date_updated": "200"},
+		{"id": "t1", "name": "Parent", "date_updated": "100"}
+	]}`, archived: `{"last_page": true, "tasks": [{"id": "t3", "name": "Old"}]}`}
+	dir := t.TempDir()
+	exporter := &Exporter{Client: s.start(t), Dir: dir, Concurrency: 2}
+
+	manifest, err := exporter.Run(Scope{Type: ScopeSpace, ID: "s1"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(manifest.Folders) != 2 || manifest.Folders[0].ID != "f1" || manifest.Folders[0].Lists[0].Tasks != 3 {
+		t.Errorf("expected folders sorted by ID with task counts, got %+v", manifest.Folders)
+	}
+	if len(manifest.Lists) != 1 || manifest.Lists[0].ID != "l9" {
+		t.Errorf("expected the folderless list, got %+v", manifest.Lists)
+	}
+
+	written, err := ReadManifest(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	list, err := ReadList(dir, written.Folders[0].Lists[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Tasks) != 3 || list.Tasks[0].ID != "t1" || list.Tasks[1].ParentID != "t1" {
+		t.Errorf("expected tasks sorted by ID with parents, got %+v", list.Tasks)
+	}
+	if list.Tasks[1].Archived || !list.Tasks[2].Archived {
+		t.Errorf("expected only t3 marked archived, got %+v", list.Tasks)
+	}
+	if len(list.Tasks[0].Comments) != 1 || list.Tasks[0].Comments[0].TextContent != "LGTM" {
+		t.Errorf("expected comments, got %+v", list.Tasks[0].Comments)
+	}
+	if !strings.Contains(s.queries[0], "include_closed=true") || !strings.Contains(s.queries[0], "subtasks=true") {
+		t.Errorf("expected closed tasks and subtasks to be fetched, got %q", s.queries[0])
+	}
+}
+
+func TestExportIsStable(t *testing.T) {
+	s := &testServer{tasks: `{"last_page": true, "tasks": [{"id": "t2"}, {"id": "t1"}]}`}
+	dir := t.TempDir()
+	client := s.start(t)
+	files := []string{filepath.Join(dir, "manifest.json"), filepath.Join(dir, "lists", "l1.json")}
+
+	read := func() []string {
+		(&Exporter{Client: client, Dir: dir}).Run(Scope{Type: ScopeSpace, ID: "s1"})
+		var contents []string
+		for _, file := range files {
+			data, _ := os.ReadFile(file)
+			contents = append(contents, string(data))
+		}
+		return contents
+	}
+	first := read()
+	time.Sleep(time.Second)
+	second := read()
+
+	for i, file := range files {
+		if len(first[i]) == 0 || first[i] != second[i] {
+			t.Errorf("expected identical %s for unchanged data", filepath.Base(file))
+		}
+	}
+}
+
+func TestExportIncremental(t *testing.T) {
+	s := &testServer{tasks: `{"last_page": true, "tasks": [
+		{"id": "t1", "name": "Old", "date_updated": "100"},
+		{"id": "t2", "name": "Kept", "date_updated": "200"}
+	]}`}
+	dir := t.TempDir()
+	client := s.start(t)
+	if _, err := (&Exporter{Client: client, Dir: dir}).Run(Scope{Type: ScopeSpace, ID: "s1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s.tasks = `{"last_page": true, "tasks": [
+		{"id": "t1", "name": "New", "date_updated": "300"},
+		{"id": "t3", "name": "Added", "date_updated": "300"}
+	]}`
+	manifest, err := (&Exporter{Client: client, Dir: dir, Incremental: true}).Run(Scope{Type: ScopeSpace, ID: "s1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(s.queries[len(s.queries)-1], "date_updated_gt=200") {
+		t.Errorf("expected tasks updated after the previous export, got %q", s.queries[len(s.queries)-1])
+	}
+	list, err := ReadList(dir, manifest.Folders[0].Lists[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for _, task := range list.Tasks {
+		names = append(names, task.Name)
+	}
+	if strings.Join(names, ",") != "New,Kept,Added" {
+		t.Errorf("expected merged tasks New,Kept,Added, got %v", names)
+	}
+}
+
+func TestExportRemovesDeletedLists(t *testing.T) {
+	s := &testServer{}
+	dir := t.TempDir()
+	stale := filepath.Join(dir, "lists", "l0.json")
+	if err := os.MkdirAll(filepath.Dir(stale), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(stale, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := (&Exporter{Client: s.start(t), Dir: dir, Incremental: true}).Run(Scope{Type: ScopeSpace, ID: "s1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("expected the deleted list's file removed, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "lists", "l1.json")); err != nil {
+		t.Errorf("expected exported lists kept, got %v", err)
+	}
+}
+
+func TestReadManifestNewerVersion(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "manifest.json"), []byte(`{"version": 99}`), 0o644)
+
+	if _, err := ReadManifest(dir); err == nil {
+		t.Error("expected error for a newer export version")
+	}
+}
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ReadManifest reads the manifest of an export directory.
+func ReadManifest(dir string) (*Manifest, error) {
+	var manifest Manifest
+	if err := readJSON(filepath.Join(dir, "manifest.json"), &manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Version > Version {
+		return nil, fmt.Errorf("export version %d is newer than supported version %d", manifest.Version, Version)
+	}
+	return &manifest, nil
+}
+
+// ReadList reads the file of a list in an export directory.
+func ReadList(dir string, ref ListRef) (*ListFile, error) {
+	var list ListFile
+	if err := readJSON(filepath.Join(dir, filepath.FromSlash(ref.File)), &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// readListFile reads a list file, returning nil if it doesn't exist.
+func readListFile(path string) (*ListFile, error) {
+	var list ListFile
+	err := readJSON(path, &list)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if list.Version != Version {
+		// Fetch everything again rather than merge into another format.
+		return nil, nil
+	}
+	return &list, nil
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// writeJSON writes v as indented JSON, replacing the file only once it is
+// fully written so that an interrupted export leaves the previous one
+// intact.
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,146 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+)
+
+// Importer creates a task for each row, parents before their subtasks.
+type Importer struct {
+	// Create creates the task for a row and returns its ID. parentID is
+	// the task created for the row's parent when the parent field names
+	// another row, and empty otherwise.
+	Create func(row Row, parentID string) (string, error)
+
+	// Report holds the rows already imported, which are skipped, and
+	// receives the outcome of each row.
+	Report *Report
+	// ReportPath is where the report is saved after each row. Nothing is
+	// saved when it is empty.
+	ReportPath string
+
+	// Log receives a line per row.
+	Log io.Writer
+
+	keys map[int]string
+}
+
+// Run imports rows, continuing past failed rows. Rows under a failed
+// parent fail too. It returns an error when any row failed.
+func (im *Importer) Run(rows []Row) error {
+	if im.Report == nil {
+		im.Report = &Report{}
+	}
+	if im.Log == nil {
+		im.Log = io.Discard
+	}
+
+	im.keys = rowKeys(rows)
+	byRef := make(map[string]*Row)
+	for i := range rows {
+		byRef[rows[i].Ref] = &rows[i]
+		byRef[RowRef(rows[i].Number)] = &rows[i]
+	}
+
+	created := make(map[int]string)
+	failed := make(map[int]error)
+	pending := rows
+	for len(pending) > 0 {
+		var waiting []Row
+		for _, row := range pending {
+			parent, isRow := byRef[row.Fields[FieldParent]]
+			if !isRow {
+				im.importRow(row, "", created, failed)
+				continue
+			}
+			if err, ok := failed[parent.Number]; ok {
+				im.fail(row, fmt.Errorf("parent row %d failed: %w", parent.Number, err), failed)
+				continue
+			}
+			if parentID, ok := created[parent.Number]; ok {
+				im.importRow(row, parentID, created, failed)
+				continue
+			}
+			waiting = append(waiting, row)
+		}
+
+		// No row could be imported, so the rest wait on each other.
+		if len(waiting) == len(pending) {
+			for _, row := range waiting {
+				im.fail(row, fmt.Errorf("parent %s is part of a cycle", row.Fields[FieldParent]), failed)
+			}
+			break
+		}
+		pending = waiting
+	}
+
+	if err := im.save(); err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%d of %d rows failed", len(failed), len(rows))
+	}
+	return nil
+}
+
+func (im *Importer) importRow(row Row, parentID string, created map[int]string, failed map[int]error) {
+	if taskID := im.Report.TaskID(im.keys[row.Number]); taskID != "" {
+		created[row.Number] = taskID
+		fmt.Fprintf(im.Log, "row %d: already imported as %s\n", row.Number, taskID)
+		return
+	}
+
+	taskID, err := im.Create(row, parentID)
+	if err != nil {
+		im.fail(row, err, failed)
+		return
+	}
+
+	created[row.Number] = taskID
+	im.Report.record(ReportRow{Row: row.Number, Key: im.keys[row.Number], Ref: row.Ref, TaskID: taskID})
+	fmt.Fprintf(im.Log, "row %d: created %s\n", row.Number, taskID)
+	if err := im.save(); err != nil {
+		fmt.Fprintf(im.Log, "row %d: failed to save report: %v\n", row.Number, err)
+	}
+}
+
+func (im *Importer) fail(row Row, err error, failed map[int]error) {
+	failed[row.Number] = err
+	im.Report.record(ReportRow{Row: row.Number, Key: im.keys[row.Number], Ref: row.Ref, Error: err.Error()})
+	fmt.Fprintf(im.Log, "row %d: failed: %v\n", row.Number, err)
+}
+
+func (im *Importer) save() error {
+	if im.ReportPath == "" {
+		return nil
+	}
+	return im.Report.Save(im.ReportPath)
+}
+
+// rowKeys identifies rows by number in a way that survives edits to other
+// rows of the file: a row's ref when the ref field is given, otherwise a
+// hash of its fields. Identical rows are told apart by their order.
+func rowKeys(rows []Row) map[int]string {
+	keys := make(map[int]string, len(rows))
+	seen := make(map[string]int)
+	for _, row := range rows {
+		key := row.Fields[FieldRef]
+		if key == "" {
+			h := sha256.New()
+			for _, name := range slices.Sorted(maps.Keys(row.Fields)) {
+				fmt.Fprintf(h, "%s=%q\n", name, row.Fields[name])
+			}
+			key = hex.EncodeToString(h.Sum(nil))[:16]
+		}
+		seen[key]++
+		if n := seen[key]; n > 1 {
+			key = fmt.Sprintf("%s/%d", key, n)
+		}
+		keys[row.Number] = key
+	}
+	return keys
+}
@@ -0,0 +1,142 @@
+package importer
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+// fakeCreate records the rows created and the parent each was given,
+// failing the rows named in fail.
+type fakeCreate struct {
+	parents map[int]string
+	fail    map[int]bool
+}
+
+func (f *fakeCreate) create(row Row, parentID string) (string, error) {
+	if f.fail[row.Number] {
+		return "", errors.New("boom")
+	}
+	if f.parents == nil {
+		f.parents = make(map[int]string)
+	}
+	f.parents[row.Number] = parentID
+	return "task" + row.Ref, nil
+}
+
+func testRows() []Row {
+	return []Row{
+		{Number: 1, Ref: "#1", Fields: map[string]string{FieldName: "Subtask", FieldParent: "GH-2"}},
+		{Number: 2, Ref: "GH-2", Fields: map[string]string{FieldName: "Epic", FieldRef: "GH-2"}},
+		{Number: 3, Ref: "#3", Fields: map[string]string{FieldName: "Nested", FieldParent: "#1"}},
+		{Number: 4, Ref: "#4", Fields: map[string]string{FieldName: "Elsewhere", FieldParent: "abc123"}},
+	}
+}
+
+func TestImporterCreatesParentsFirst(t *testing.T) {
+	fake := &fakeCreate{}
+	im := &Importer{Create: fake.create}
+
+	if err := im.Run(testRows()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[int]string{1: "taskGH-2", 2: "", 3: "task#1", 4: ""}
+	for number, parent := range want {
+		if got, ok := fake.parents[number]; !ok || got != parent {
+			t.Errorf("row %d: expected parent %q, got %q (created: %t)", number, parent, got, ok)
+		}
+	}
+	if im.Report.TaskID("GH-2") != "taskGH-2" {
+		t.Errorf("expected row 2 in the report, got %+v", im.Report.Rows)
+	}
+}
+
+func TestImporterFailsSubtasksOfFailedRows(t *testing.T) {
+	fake := &fakeCreate{fail: map[int]bool{2: true}}
+	im := &Importer{Create: fake.create}
+
+	err := im.Run(testRows())
+
+	if err == nil || err.Error() != "3 of 4 rows failed" {
+		t.Errorf("expected '3 of 4 rows failed', got %v", err)
+	}
+	if _, ok := fake.parents[4]; !ok {
+		t.Error("expected the unrelated row to be created")
+	}
+}
+
+func TestImporterResumesFromReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	first := &Importer{Create: (&fakeCreate{fail: map[int]bool{4: true}}).create, ReportPath: path}
+	if err := first.Run(testRows()); err == nil {
+		t.Fatal("expected the first run to fail")
+	}
+
+	report, err := LoadReport(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fake := &fakeCreate{}
+	second := &Importer{Create: fake.create, Report: report, ReportPath: path}
+	if err := second.Run(testRows()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(fake.parents) != 1 {
+		t.Errorf("expected only the failed row to be created again, got %v", fake.parents)
+	}
+	if _, ok := fake.parents[4]; !ok {
+		t.Error("expected row 4 to be retried")
+	}
+}
+
+func TestImporterResumesEditedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	rows := []Row{
+		{Number: 1, Ref: "#1", Fields: map[string]string{FieldName: "First"}},
+		{Number: 2, Ref: "#2", Fields: map[string]string{FieldName: "Broken", FieldStatus: "shipped"}},
+		{Number: 3, Ref: "#3", Fields: map[string]string{FieldName: "Third"}},
+	}
+	first := &Importer{Create: (&fakeCreate{fail: map[int]bool{2: true}}).create, ReportPath: path}
+	if err := first.Run(rows); err == nil {
+		t.Fatal("expected the first run to fail")
+	}
+
+	// The broken row is fixed and a row is inserted above it, shifting
+	// the row numbers of the rows already imported.
+	edited := []Row{
+		{Number: 1, Ref: "#1", Fields: map[string]string{FieldName: "First"}},
+		{Number: 2, Ref: "#2", Fields: map[string]string{FieldName: "Inserted"}},
+		{Number: 3, Ref: "#3", Fields: map[string]string{FieldName: "Broken"}},
+		{Number: 4, Ref: "#4", Fields: map[string]string{FieldName: "Third"}},
+	}
+	report, err := LoadReport(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fake := &fakeCreate{}
+	second := &Importer{Create: fake.create, Report: report}
+	if err := second.Run(edited); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(fake.parents) != 2 {
+		t.Errorf("expected only the inserted and fixed rows created, got %v", fake.parents)
+	}
+	if _, ok := fake.parents[4]; ok {
+		t.Error("expected the shifted row to be recognized as imported")
+	}
+}
+
+func TestImporterDetectsCycles(t *testing.T) {
+	rows := []Row{
+		{Number: 1, Ref: "#1", Fields: map[string]string{FieldName: "A", FieldParent: "#2"}},
+		{Number: 2, Ref: "#2", Fields: map[string]string{FieldName: "B", FieldParent: "#1"}},
+	}
+	im := &Importer{Create: (&fakeCreate{}).create}
+
+	if err := im.Run(rows); err == nil {
+		t.Error("expected error for rows that are each other's parent")
+	}
+}
@@ -0,0 +1,119 @@
+package importer
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Task fields that columns can be mapped to.
+const (
+	FieldRef                 = "ref"
+	FieldName                = "name"
+	FieldDescription         = "description"
+	FieldMarkdownDescription = "markdown_description"
+	FieldStatus              = "status"
+	FieldPriority            = "priority"
+	FieldAssignees           = "assignees"
+	FieldTags                = "tags"
+	FieldDueDate             = "due_date"
+	FieldStartDate           = "start_date"
+	FieldTimeEstimate        = "time_estimate"
+	FieldPoints              = "points"
+	FieldParent              = "parent"
+)
+
+// Fields lists every task field, in the order they are documented.
+var Fields = []string{
+	FieldRef,
+	FieldName,
+	FieldDescription,
+	FieldMarkdownDescription,
+	FieldStatus,
+	FieldPriority,
+	FieldAssignees,
+	FieldTags,
+	FieldDueDate,
+	FieldStartDate,
+	FieldTimeEstimate,
+	FieldPoints,
+	FieldParent,
+}
+
+// Mapping maps task fields to the input columns holding them.
+type Mapping map[string]string
+
+// LoadMapping reads a mapping file: a YAML or JSON object from task field
+// to column name, such as {"name": "Title", "assignees": "Owner"}.
+func LoadMapping(path string) (Mapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var mapping Mapping
+	if err := yaml.Unmarshal(data, &mapping); err != nil {
+		return nil, fmt.Errorf("failed to parse mapping file: %w", err)
+	}
+	for field := range mapping {
+		if !slices.Contains(Fields, field) {
+			return nil, fmt.Errorf("unknown task field %q in mapping file (valid fields: %s)", field, strings.Join(Fields, ", "))
+		}
+	}
+	return mapping, nil
+}
+
+// Row is a record with its columns mapped to task fields.
+type Row struct {
+	Number int
+	// Ref is how other rows name this row as their parent: the ref field
+	// when mapped, otherwise "#" and the row number.
+	Ref    string
+	Fields map[string]string
+}
+
+// Apply maps a record to task fields. Without a mapping, columns named
+// like a task field (ignoring case) are used.
+func (m Mapping) Apply(record Record) Row {
+	row := Row{Number: record.Row, Fields: make(map[string]string)}
+	for _, field := range Fields {
+		column, ok := m[field]
+		if !ok && m == nil {
+			column = field
+		}
+		if column == "" {
+			continue
+		}
+		if value, ok := lookup(record.Values, column); ok && strings.TrimSpace(value) != "" {
+			row.Fields[field] = strings.TrimSpace(value)
+		}
+	}
+
+	row.Ref = row.Fields[FieldRef]
+	if row.Ref == "" {
+		row.Ref = RowRef(record.Row)
+	}
+	return row
+}
+
+// RowRef is the reference to a row by number, such as "#3".
+func RowRef(number int) string {
+	return "#" + strconv.Itoa(number)
+}
+
+// lookup finds a column by exact name, then ignoring case.
+func lookup(values map[string]string, column string) (string, bool) {
+	if value, ok := values[column]; ok {
+		return value, true
+	}
+	for key, value := range values {
+		if strings.EqualFold(key, column) {
+			return value, true
+		}
+	}
+	return "", false
+}
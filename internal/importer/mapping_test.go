@@ -0,0 +1,57 @@
+package importer
+
+import (
+	"testing"
+)
+
+func TestLoadMapping(t *testing.T) {
+	path := writeFile(t, "mapping.yaml", "name: Title\nassignees: Owner\nref: Issue\n")
+
+	mapping, err := LoadMapping(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mapping[FieldName] != "Title" || mapping[FieldAssignees] != "Owner" || mapping[FieldRef] != "Issue" {
+		t.Errorf("unexpected mapping %v", mapping)
+	}
+}
+
+func TestLoadMappingUnknownField(t *testing.T) {
+	path := writeFile(t, "mapping.json", `{"title": "Title"}`)
+
+	if _, err := LoadMapping(path); err == nil {
+		t.Error("expected error for an unknown task field")
+	}
+}
+
+func TestMappingApply(t *testing.T) {
+	mapping := Mapping{FieldName: "Title", FieldParent: "Parent", FieldRef: "Issue"}
+	record := Record{Row: 3, Values: map[string]string{"title": " Fix login ", "Parent": "GH-1", "Issue": "GH-2", "status": "done"}}
+
+	row := mapping.Apply(record)
+
+	if row.Number != 3 || row.Ref != "GH-2" {
+		t.Errorf("expected row 3 with ref GH-2, got %+v", row)
+	}
+	if row.Fields[FieldName] != "Fix login" || row.Fields[FieldParent] != "GH-1" {
+		t.Errorf("unexpected fields %v", row.Fields)
+	}
+	if _, ok := row.Fields[FieldStatus]; ok {
+		t.Error("expected unmapped columns to be ignored")
+	}
+}
+
+func TestMappingApplyDefault(t *testing.T) {
+	var mapping Mapping
+	record := Record{Row: 2, Values: map[string]string{"Name": "Fix login", "Status": "done", "Other": "x"}}
+
+	row := mapping.Apply(record)
+
+	if row.Ref != "#2" {
+		t.Errorf("expected row reference #2, got %q", row.Ref)
+	}
+	if row.Fields[FieldName] != "Fix login" || row.Fields[FieldStatus] != "done" || len(row.Fields) != 2 {
+		t.Errorf("expected columns matched by field name, got %v", row.Fields)
+	}
+}
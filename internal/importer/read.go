@@ -0,0 +1,141 @@
+// Package importer creates tasks from rows of CSV, JSON, or YAML files.
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Record is one row of an input file, keyed by column name.
+type Record struct {
+	// Row is the 1-based position of the record among the file's records,
+	// not counting a CSV header.
+	Row    int
+	Values map[string]string
+}
+
+// ReadFile reads the records of a .csv, .json, .yaml, or .yml file.
+func ReadFile(path string) ([]Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readCSV(data)
+	case ".json":
+		return readJSON(data)
+	case ".yaml", ".yml":
+		return readYAML(data)
+	}
+	return nil, fmt.Errorf("unsupported file type %q (expected .csv, .json, .yaml, or .yml)", filepath.Ext(path))
+}
+
+// readCSV reads rows keyed by the header row.
+func readCSV(data []byte) ([]Record, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var records []Record
+	for {
+		line, err := r.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		record := Record{Row: len(records) + 1, Values: make(map[string]string)}
+		for i, value := range line {
+			if i < len(header) {
+				record.Values[header[i]] = value
+			}
+		}
+		records = append(records, record)
+	}
+}
+
+// readJSON reads an array of objects. Numbers are kept as written so that
+// timestamps don't turn into floats.
+func readJSON(data []byte) ([]Record, error) {
+	var rows []map[string]any
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON (expected an array of objects): %w", err)
+	}
+	return toRecords(rows), nil
+}
+
+// readYAML reads a sequence of mappings.
+func readYAML(data []byte) ([]Record, error) {
+	var rows []map[string]any
+	if err := yaml.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML (expected a list of mappings): %w", err)
+	}
+	return toRecords(rows), nil
+}
+
+func toRecords(rows []map[string]any) []Record {
+	records := make([]Record, 0, len(rows))
+	for i, row := range rows {
+		record := Record{Row: i + 1, Values: make(map[string]string)}
+		for key, value := range row {
+			record.Values[key] = stringValue(value)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// stringValue flattens a decoded value into the text a CSV cell would
+// hold. Lists, such as several assignees, become comma-separated.
+func stringValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			parts = append(parts, stringValue(item))
+		}
+		return strings.Join(parts, ",")
+	}
+	return fmt.Sprint(value)
+}
+
+// SplitList splits a comma-separated cell into its trimmed, non-empty
+// items.
+func SplitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,84 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReadFileCSV(t *testing.T) {
+	path := writeFile(t, "tasks.csv", "\ufeffTitle, Owner\nFix login,\"jane,john\"\nShip it,\n")
+
+	records, err := ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if records[0].Row != 1 || records[0].Values["Title"] != "Fix login" || records[0].Values["Owner"] != "jane,john" {
+		t.Errorf("unexpected first record %+v", records[0])
+	}
+	if records[1].Row != 2 || records[1].Values["Title"] != "Ship it" {
+		t.Errorf("unexpected second record %+v", records[1])
+	}
+}
+
+func TestReadFileJSON(t *testing.T) {
+	path := writeFile(t, "tasks.json", `[{"name": "Fix login", "due_date": 1736899200000, "assignees": ["jane", 42]}]`)
+
+	records, err := ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	values := records[0].Values
+	if values["due_date"] != "1736899200000" {
+		t.Errorf("expected timestamp kept as written, got %q", values["due_date"])
+	}
+	if values["assignees"] != "jane,42" {
+		t.Errorf("expected comma-separated list, got %q", values["assignees"])
+	}
+}
+
+func TestReadFileYAML(t *testing.T) {
+	path := writeFile(t, "tasks.yml", "- name: Fix login\n  points: 3\n- name: Ship it\n  tags: [release, v2]\n")
+
+	records, err := ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 2 || records[0].Values["points"] != "3" || records[1].Values["tags"] != "release,v2" {
+		t.Errorf("unexpected records %+v", records)
+	}
+}
+
+func TestReadFileUnsupported(t *testing.T) {
+	path := writeFile(t, "tasks.txt", "Fix login")
+
+	if _, err := ReadFile(path); err == nil {
+		t.Error("expected error for an unsupported file type")
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	got := SplitList(" jane, ,john ")
+	if !slices.Equal(got, []string{"jane", "john"}) {
+		t.Errorf("expected [jane john], got %v", got)
+	}
+}
@@ -0,0 +1,75 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// Report records the task created for each row, so that an import can be
+// resumed after a partial failure without creating duplicates.
+type Report struct {
+	Source string      `json:"source"`
+	Rows   []ReportRow `json:"rows"`
+}
+
+// ReportRow is the outcome of importing one row: the created task's ID, or
+// the error that stopped it. Rows are matched by Key when resuming, since
+// row numbers shift when the file is edited.
+type ReportRow struct {
+	Row    int    `json:"row"`
+	Key    string `json:"key"`
+	Ref    string `json:"ref,omitempty"`
+	TaskID string `json:"task_id,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// LoadReport reads a report, returning an empty one if the file doesn't
+// exist yet.
+func LoadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Report{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse report %s: %w", path, err)
+	}
+	return &report, nil
+}
+
+// Save writes the report to path.
+func (r *Report) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// TaskID returns the task already created for the row with key, if any.
+func (r *Report) TaskID(key string) string {
+	for _, row := range r.Rows {
+		if row.Key == key {
+			return row.TaskID
+		}
+	}
+	return ""
+}
+
+// record replaces the outcome of a row.
+func (r *Report) record(result ReportRow) {
+	for i, row := range r.Rows {
+		if row.Key == result.Key {
+			r.Rows[i] = result
+			return
+		}
+	}
+	r.Rows = append(r.Rows, result)
+}
@@ -0,0 +1,104 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+)
+
+// Colored is implemented by rows with fields shown in a color when color
+// output is enabled, such as a status in the hex color ClickUp assigns to
+// it. The fields stay plain strings, so machine-readable formats and
+// templates never see the color.
+type Colored interface {
+	FieldColor(name string) string
+}
+
+// ColorEnabled decides whether to colorize output written to file. mode is
+// "always", "never", or "auto"; auto enables color only on a terminal and
+// when NO_COLOR is unset.
+func ColorEnabled(mode string, file *os.File) (bool, error) {
+	switch mode {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "", "auto":
+		if _, ok := os.LookupEnv("NO_COLOR"); ok {
+			return false, nil
+		}
+		return IsTerminal(file), nil
+	}
+	return false, fmt.Errorf("invalid color mode %q (expected auto, always, or never)", mode)
+}
+
+// SetColor enables ANSI colors in text, table, and template output.
+func (f *Formatter) SetColor(enabled bool) {
+	f.color = enabled
+}
+
+// colorize wraps text in the ANSI sequence for color, given by name or as
+// a hex "#rrggbb" value mapped to the 256-color terminal palette.
+func (f *Formatter) colorize(color, text string) string {
+	if !f.color || text == "" {
+		return text
+	}
+	if code, ok := ansiColors[color]; ok {
+		return "\x1b[" + code + "m" + text + "\x1b[0m"
+	}
+	if r, g, b, ok := parseHexColor(color); ok {
+		return fmt.Sprintf("\x1b[38;5;%dm%s\x1b[0m", palette256(r, g, b), text)
+	}
+	return text
+}
+
+// displayValue is cellValue for human-readable formats: fields of Colored
+// rows are colorized.
+func (f *Formatter) displayValue(row reflect.Value, field int) (string, error) {
+	text, err := f.cellValue(row.Field(field))
+	if err != nil {
+		return "", err
+	}
+	if row.CanInterface() {
+		if colored, ok := row.Interface().(Colored); ok {
+			return f.colorize(colored.FieldColor(row.Type().Field(field).Name), text), nil
+		}
+	}
+	return text, nil
+}
+
+// palette256 maps an RGB color to the closest entry of the xterm 6x6x6
+// color cube or grayscale ramp.
+func palette256(r, g, b uint8) int {
+	cube := func(c uint8) int {
+		if c < 48 {
+			return 0
+		}
+		if c < 115 {
+			return 1
+		}
+		return (int(c) - 35) / 40
+	}
+	levels := []int{0, 95, 135, 175, 215, 255}
+	cr, cg, cb := cube(r), cube(g), cube(b)
+	cubeIndex := 16 + 36*cr + 6*cg + cb
+
+	avg := (int(r) + int(g) + int(b)) / 3
+	grayIndex := 23
+	if avg < 238 {
+		grayIndex = (avg - 3) / 10
+	}
+	if grayIndex < 0 {
+		grayIndex = 0
+	}
+	gray := 8 + 10*grayIndex
+
+	dist := func(x, y, z int) int {
+		dr, dg, db := int(r)-x, int(g)-y, int(b)-z
+		return dr*dr + dg*dg + db*db
+	}
+	if dist(gray, gray, gray) < dist(levels[cr], levels[cg], levels[cb]) {
+		return 232 + grayIndex
+	}
+	return cubeIndex
+}
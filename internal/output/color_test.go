@@ -0,0 +1,153 @@
+package output
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+type coloredTask struct {
+	ID     string
+	Title  string
+	Status string
+
+	statusColor string
+}
+
+func (t coloredTask) FieldColor(name string) string {
+	if name == "Status" {
+		return t.statusColor
+	}
+	return ""
+}
+
+func TestColorEnabled(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	tests := []struct {
+		mode     string
+		noColor  bool
+		expected bool
+	}{
+		{"always", false, true},
+		{"always", true, true},
+		{"never", false, false},
+		{"auto", false, false},
+		{"", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			if tt.noColor {
+				t.Setenv("NO_COLOR", "1")
+			}
+
+			enabled, err := ColorEnabled(tt.mode, file)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if enabled != tt.expected {
+				t.Errorf("ColorEnabled(%q) = %v, want %v", tt.mode, enabled, tt.expected)
+			}
+		})
+	}
+}
+
+func TestColorEnabled_InvalidMode(t *testing.T) {
+	_, err := ColorEnabled("sometimes", os.Stdout)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestPalette256(t *testing.T) {
+	tests := []struct {
+		hex      string
+		expected int
+	}{
+		{"#ff0000", 196},
+		{"#00ff00", 46},
+		{"#000000", 16},
+		{"#808080", 244},
+		{"#d3d3d3", 252},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.hex, func(t *testing.T) {
+			r, g, b, _ := parseHexColor(tt.hex)
+
+			if got := palette256(r, g, b); got != tt.expected {
+				t.Errorf("palette256(%s) = %d, want %d", tt.hex, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTextFormatter_ColorsColoredFields(t *testing.T) {
+	task := coloredTask{ID: "abc123", Status: "open", statusColor: "#ff0000"}
+	formatter, _ := NewFormatter("text")
+	formatter.SetColor(true)
+
+	output, err := formatter.Format(task)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Status: \x1b[38;5;196mopen\x1b[0m") {
+		t.Errorf("expected colored status, got %q", output)
+	}
+}
+
+func TestTextFormatter_NoColorByDefault(t *testing.T) {
+	task := coloredTask{ID: "abc123", Status: "open", statusColor: "#ff0000"}
+	formatter, _ := NewFormatter("text")
+
+	output, err := formatter.Format(task)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output, "\x1b") {
+		t.Errorf("expected plain output, got %q", output)
+	}
+}
+
+func TestTableFormatter_AlignsColoredColumns(t *testing.T) {
+	tasks := []coloredTask{
+		{ID: "a", Title: "One", Status: "open", statusColor: "red"},
+		{ID: "b", Title: "Two", Status: "in progress", statusColor: "green"},
+	}
+	formatter, _ := NewFormatter("table")
+	formatter.SetColor(true)
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(ansiPattern.ReplaceAllString(output, ""), "\n")
+	if strings.Index(lines[0], "STATUS") != strings.Index(lines[2], "in progress") {
+		t.Errorf("colored column should stay aligned:\n%s", strings.Join(lines, "\n"))
+	}
+}
+
+func TestJSONFormatter_IgnoresColors(t *testing.T) {
+	task := coloredTask{ID: "abc123", Status: "open", statusColor: "#ff0000"}
+	formatter, _ := NewFormatter("json")
+	formatter.SetColor(true)
+
+	output, err := formatter.Format(task)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, `"Status": "open"`) {
+		t.Errorf("colored fields should marshal as plain strings, got %s", output)
+	}
+}
@@ -0,0 +1,89 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// formatDelimited renders structs as CSV or TSV with a header row. A single
+// struct becomes a one-row document. Quoting follows RFC 4180 for both
+// separators, so embedded separators, quotes, and newlines survive.
+func (f *Formatter) formatDelimited(data any, comma rune) (string, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		slice := reflect.MakeSlice(reflect.SliceOf(v.Type()), 0, 1)
+		v = reflect.Append(slice, v)
+	}
+
+	elemType := v.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return "", fmt.Errorf("cannot format %s as delimited output", elemType)
+	}
+
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+	w.Comma = comma
+
+	fields := exportedFields(elemType)
+	header := make([]string, len(fields))
+	for col, idx := range fields {
+		header[col] = elemType.Field(idx).Name
+	}
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		item := v.Index(i)
+		if item.Kind() == reflect.Ptr {
+			item = item.Elem()
+		}
+		record := make([]string, len(fields))
+		for col, idx := range fields {
+			value, err := f.cellValue(item.Field(idx))
+			if err != nil {
+				return "", err
+			}
+			record[col] = value
+		}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
+
+// cellValue flattens a field into a single cell. Slices of structs, such as
+// comments in the details view, are joined with "; " and slices of strings,
+// such as tags, with ", ".
+func (f *Formatter) cellValue(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String {
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = v.Index(i).String()
+		}
+		return strings.Join(parts, ", "), nil
+	}
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Struct {
+		parts := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			part, err := f.formatStruct(v.Index(i))
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		return strings.Join(parts, "; "), nil
+	}
+	return fmt.Sprintf("%v", v.Interface()), nil
+}
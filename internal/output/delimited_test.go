@@ -0,0 +1,107 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+func TestCSVFormatter_List(t *testing.T) {
+	tasks := []sampleTask{
+		{ID: "abc123", Title: "Task 1", Status: "open"},
+		{ID: "def456", Title: "Task 2", Status: "closed"},
+	}
+	formatter, _ := NewFormatter("csv")
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "ID,Title,Assignee,Status,Priority\n" +
+		"abc123,Task 1,,open,\n" +
+		"def456,Task 2,,closed,"
+	if output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestCSVFormatter_Escaping(t *testing.T) {
+	tasks := []sampleTask{
+		{ID: "abc123", Title: `Fix "login", then deploy`},
+		{ID: "def456", Title: "Line one\nLine two"},
+	}
+	formatter, _ := NewFormatter("csv")
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, `"Fix ""login"", then deploy"`) {
+		t.Errorf("commas and quotes should be escaped, got:\n%s", output)
+	}
+	if !strings.Contains(output, "\"Line one\nLine two\"") {
+		t.Errorf("newlines should be quoted, got:\n%s", output)
+	}
+}
+
+func TestTSVFormatter_List(t *testing.T) {
+	tasks := []sampleTask{
+		{ID: "abc123", Title: "Task\twith tab"},
+	}
+	formatter, _ := NewFormatter("tsv")
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(output, "\n")
+	if lines[0] != "ID\tTitle\tAssignee\tStatus\tPriority" {
+		t.Errorf("unexpected header: %q", lines[0])
+	}
+	if lines[1] != "abc123\t\"Task\twith tab\"\t\t\t" {
+		t.Errorf("embedded tabs should be quoted, got: %q", lines[1])
+	}
+}
+
+func TestCSVFormatter_SingleItem(t *testing.T) {
+	formatter, _ := NewFormatter("csv")
+
+	output, err := formatter.Format(sampleTask{ID: "abc123", Title: "Test"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(strings.Split(output, "\n")) != 2 {
+		t.Errorf("expected header and one row, got:\n%s", output)
+	}
+}
+
+func TestFormatTaskList_CSV(t *testing.T) {
+	tasks := []api.Task{
+		{
+			ID:   "parent",
+			Name: "Parent Task",
+			Subtasks: []api.Task{
+				{ID: "child", Name: "Child Task"},
+			},
+		},
+	}
+	formatter, _ := NewFormatter("csv")
+
+	output, err := formatter.FormatTaskList(tasks, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(output, "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got:\n%s", output)
+	}
+	if !strings.HasPrefix(lines[2], "child,Child Task,") {
+		t.Errorf("subtask rows should not be indented, got: %q", lines[2])
+	}
+}
@@ -4,25 +4,79 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
+	"text/template"
 
 	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
 )
 
 type Formatter struct {
-	format string
+	format   string
+	width    int
+	color    bool
+	template *template.Template
 }
 
-func NewFormatter(format string) *Formatter {
-	if format == "" || (format != "text" && format != "json") {
+// Formats lists the output formats accepted by NewFormatter.
+var Formats = []string{"text", "json", "table", "csv", "tsv", "yaml", "ndjson", "template", "markdown"}
+
+func NewFormatter(format string) (*Formatter, error) {
+	if format == "" {
 		format = "text"
 	}
-	return &Formatter{format: format}
+	if !slices.Contains(Formats, format) {
+		return nil, fmt.Errorf("unknown output format %q (expected one of: %s)", format, strings.Join(Formats, ", "))
+	}
+	return &Formatter{format: format}, nil
+}
+
+// SetWidth sets the terminal width used to truncate table output.
+// A width of 0 disables truncation.
+func (f *Formatter) SetWidth(width int) {
+	f.width = width
+}
+
+// Streaming reports whether list output can be written incrementally, one
+// page at a time, without the rows of one page affecting another.
+func (f *Formatter) Streaming() bool {
+	return f.format == "ndjson"
+}
+
+// Structured reports whether output is a data format that can hold
+// nested values, such as a full task with its subtasks and checklists.
+func (f *Formatter) Structured() bool {
+	switch f.format {
+	case "json", "yaml", "ndjson":
+		return true
+	}
+	return false
+}
+
+// Markdown reports whether output is a markdown document, which renders
+// tasks as a checklist tree rather than as rows.
+func (f *Formatter) Markdown() bool {
+	return f.format == "markdown"
 }
 
 func (f *Formatter) Format(data any) (string, error) {
-	if f.format == "json" {
+	switch f.format {
+	case "json":
 		return f.formatJSON(data)
+	case "yaml":
+		return f.formatYAML(data)
+	case "ndjson":
+		return f.formatNDJSON(data)
+	case "template":
+		return f.formatTemplate(data)
+	case "table":
+		return f.formatTable(data)
+	case "csv":
+		return f.formatDelimited(data, ',')
+	case "tsv":
+		return f.formatDelimited(data, '\t')
+	case "markdown":
+		return f.formatMarkdown(data)
 	}
 	return f.formatText(data)
 }
@@ -51,7 +105,7 @@ func (f *Formatter) formatSlice(v reflect.Value) (string, error) {
 		if err != nil {
 			return "", err
 		}
-		lines = append(lines, line)
+		lines = append(lines, indentPrefix(item)+line)
 	}
 	return strings.Join(lines, "\n"), nil
 }
@@ -66,20 +120,31 @@ func (f *Formatter) formatStruct(v reflect.Value) (string, error) {
 
 	t := v.Type()
 	var parts []string
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		value := v.Field(i)
-		if !field.IsExported() {
-			continue
+	for _, i := range exportedFields(t) {
+		strVal, err := f.displayValue(v, i)
+		if err != nil {
+			return "", err
 		}
-		strVal := fmt.Sprintf("%v", value.Interface())
 		if strVal != "" {
-			parts = append(parts, fmt.Sprintf("%s: %s", field.Name, strVal))
+			parts = append(parts, fmt.Sprintf("%s: %s", t.Field(i).Name, strVal))
 		}
 	}
 	return strings.Join(parts, " | "), nil
 }
 
+// exportedFields returns the indexes of the exported fields of a struct
+// type, in declaration order. Every text-like format walks these fields.
+// Fields left out of json output are left out here too.
+func exportedFields(t reflect.Type) []int {
+	var fields []int
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.IsExported() && field.Tag.Get("json") != "-" {
+			fields = append(fields, i)
+		}
+	}
+	return fields
+}
+
 func (f *Formatter) FormatTaskList(tasks []api.Task, recursive bool) (string, error) {
 	if f.format == "json" {
 		b, err := json.MarshalIndent(tasks, "", "  ")
@@ -88,6 +153,16 @@ func (f *Formatter) FormatTaskList(tasks []api.Task, recursive bool) (string, er
 		}
 		return string(b), nil
 	}
+	switch f.format {
+	case "yaml", "ndjson", "template":
+		return f.Format(tasks)
+	case "markdown":
+		return f.formatTaskListMarkdown(tasks, recursive), nil
+	case "table":
+		return f.formatTable(taskRows(tasks, 0, recursive))
+	case "csv", "tsv":
+		return f.Format(taskRows(tasks, 0, recursive))
+	}
 	result, err := f.formatTaskListText(tasks, recursive)
 	return result, err
 }
@@ -123,10 +198,16 @@ func (f *Formatter) formatTaskWithIndent(task api.Task, indent int) []string {
 }
 
 func (f *Formatter) formatSubtasksText(tasks []api.Task, indent int) []string {
+	return walkSubtasks(tasks, indent, f.formatTaskWithIndent)
+}
+
+// walkSubtasks renders a task tree depth-first, each task followed by its
+// subtasks one level deeper.
+func walkSubtasks(tasks []api.Task, indent int, render func(api.Task, int) []string) []string {
 	var lines []string
 	for _, task := range tasks {
-		lines = append(lines, f.formatTaskWithIndent(task, indent)...)
-		lines = append(lines, f.formatSubtasksText(task.Subtasks, indent+1)...)
+		lines = append(lines, render(task, indent)...)
+		lines = append(lines, walkSubtasks(task.Subtasks, indent+1, render)...)
 	}
 	return lines
 }
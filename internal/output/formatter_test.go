@@ -23,7 +23,7 @@ func TestTextFormatter_SingleItem(t *testing.T) {
 		Status:   "in progress",
 		Priority: "high",
 	}
-	formatter := NewFormatter("text")
+	formatter, _ := NewFormatter("text")
 
 	output, err := formatter.Format(task)
 
@@ -46,7 +46,7 @@ func TestJSONFormatter_SingleItem(t *testing.T) {
 		Status:   "in progress",
 		Priority: "high",
 	}
-	formatter := NewFormatter("json")
+	formatter, _ := NewFormatter("json")
 
 	output, err := formatter.Format(task)
 
@@ -66,7 +66,7 @@ func TestTextFormatter_List(t *testing.T) {
 		{ID: "abc123", Title: "Task 1", Status: "open"},
 		{ID: "def456", Title: "Task 2", Status: "closed"},
 	}
-	formatter := NewFormatter("text")
+	formatter, _ := NewFormatter("text")
 
 	output, err := formatter.Format(tasks)
 
@@ -86,7 +86,7 @@ func TestJSONFormatter_List(t *testing.T) {
 		{ID: "abc123", Title: "Task 1", Status: "open"},
 		{ID: "def456", Title: "Task 2", Status: "closed"},
 	}
-	formatter := NewFormatter("json")
+	formatter, _ := NewFormatter("json")
 
 	output, err := formatter.Format(tasks)
 
@@ -102,7 +102,7 @@ func TestJSONFormatter_List(t *testing.T) {
 }
 
 func TestFormatter_DefaultsToText(t *testing.T) {
-	formatter := NewFormatter("")
+	formatter, _ := NewFormatter("")
 	task := sampleTask{ID: "abc123", Title: "Test"}
 
 	output, err := formatter.Format(task)
@@ -116,16 +116,16 @@ func TestFormatter_DefaultsToText(t *testing.T) {
 }
 
 func TestFormatter_InvalidFormat(t *testing.T) {
-	formatter := NewFormatter("xml")
+	formatter, err := NewFormatter("xml")
 
-	task := sampleTask{ID: "abc123", Title: "Test"}
-	output, err := formatter.Format(task)
-
-	if err != nil {
-		t.Fatalf("should not error, got: %v", err)
+	if err == nil {
+		t.Fatal("expected error for unknown format, got nil")
 	}
-	if output == "" {
-		t.Error("should fall back to text format")
+	if formatter != nil {
+		t.Error("formatter should be nil for unknown format")
+	}
+	if !strings.Contains(err.Error(), "xml") {
+		t.Errorf("error should mention the rejected format, got: %v", err)
 	}
 }
 
@@ -134,7 +134,7 @@ func TestJSONFormatter_PrettyPrint(t *testing.T) {
 		ID:    "abc123",
 		Title: "Test",
 	}
-	formatter := NewFormatter("json")
+	formatter, _ := NewFormatter("json")
 
 	output, err := formatter.Format(task)
 
@@ -157,7 +157,7 @@ func TestFormatTaskList_Flat(t *testing.T) {
 			Name: "Task 2",
 		},
 	}
-	formatter := NewFormatter("text")
+	formatter, _ := NewFormatter("text")
 
 	output, err := formatter.FormatTaskList(tasks, false)
 
@@ -188,7 +188,7 @@ func TestFormatTaskList_Recursive(t *testing.T) {
 			},
 		},
 	}
-	formatter := NewFormatter("text")
+	formatter, _ := NewFormatter("text")
 
 	output, err := formatter.FormatTaskList(tasks, true)
 
@@ -230,7 +230,7 @@ func TestFormatTaskList_JSON(t *testing.T) {
 			Name: "Task 1",
 		},
 	}
-	formatter := NewFormatter("json")
+	formatter, _ := NewFormatter("json")
 
 	output, err := formatter.FormatTaskList(tasks, false)
 
@@ -264,7 +264,7 @@ func TestFormatTaskList_HierarchicalIndentation(t *testing.T) {
 			},
 		},
 	}
-	formatter := NewFormatter("text")
+	formatter, _ := NewFormatter("text")
 
 	output, err := formatter.FormatTaskList(tasks, true)
 
@@ -0,0 +1,173 @@
+package output
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Section is a titled block of free-form text in a details view, such as a
+// task description or its comments.
+type Section struct {
+	Title string
+	Body  string
+}
+
+// FormatDetails renders a single record. Text output lists the fields of
+// header as aligned lines followed by each non-empty section; every other
+// format renders data as Format would.
+func (f *Formatter) FormatDetails(data any, header any, sections ...Section) (string, error) {
+	if f.format != "text" {
+		return f.Format(data)
+	}
+
+	v := reflect.ValueOf(header)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	t := v.Type()
+	fields := exportedFields(t)
+	nameWidth := 0
+	for _, i := range fields {
+		if n := len(t.Field(i).Name) + 1; n > nameWidth {
+			nameWidth = n
+		}
+	}
+
+	var lines []string
+	for _, i := range fields {
+		value, err := f.displayValue(v, i)
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, strings.TrimRight(padRight(t.Field(i).Name+":", nameWidth)+columnGap+value, " "))
+	}
+
+	for _, section := range sections {
+		if strings.TrimSpace(section.Body) == "" {
+			continue
+		}
+		lines = append(lines, "", f.colorize("bold", section.Title), strings.Repeat("─", visibleWidth(section.Title)))
+		lines = append(lines, strings.TrimRight(section.Body, "\n"))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+var (
+	headingPattern    = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	bulletPattern     = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	checkboxPattern   = regexp.MustCompile(`^\[([ xX])\]\s+(.*)$`)
+	orderedPattern    = regexp.MustCompile(`^(\s*)(\d+)[.)]\s+(.*)$`)
+	rulePattern       = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+	codeSpanPattern   = regexp.MustCompile("`([^`]+)`")
+	linkPattern       = regexp.MustCompile(`!?\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	boldPattern       = regexp.MustCompile(`\*\*(.+?)\*\*|__(.+?)__`)
+	italicPattern     = regexp.MustCompile(`\*([^*\s][^*]*?)\*|\b_([^_\s][^_]*?)_\b`)
+	codeSpanSentinel  = "\x00"
+	codeSpanSeparator = regexp.MustCompile("\x00(\\d+)\x00")
+)
+
+// RenderMarkdown renders markdown as styled terminal text: headings,
+// bullet, numbered and task lists, block quotes, rules, fenced code
+// blocks, and inline emphasis, code spans, and links. Without color the
+// markup is still simplified so the text reads naturally.
+func (f *Formatter) RenderMarkdown(src string) string {
+	var out []string
+	inCode := false
+	fence := ""
+
+	for _, line := range strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if inCode {
+			if strings.HasPrefix(trimmed, fence) {
+				inCode = false
+				continue
+			}
+			out = append(out, "    "+f.colorize("gray", line))
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inCode = true
+			fence = trimmed[:3]
+			continue
+		}
+
+		if m := headingPattern.FindStringSubmatch(line); m != nil {
+			text := f.renderInline(m[2])
+			out = append(out, f.colorize("bold", text))
+			switch len(m[1]) {
+			case 1:
+				out = append(out, strings.Repeat("=", visibleWidth(text)))
+			case 2:
+				out = append(out, strings.Repeat("-", visibleWidth(text)))
+			}
+			continue
+		}
+
+		if rulePattern.MatchString(line) {
+			out = append(out, f.colorize("gray", strings.Repeat("─", 40)))
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, ">") {
+			quote := strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))
+			out = append(out, f.colorize("gray", "│ ")+f.renderInline(quote))
+			continue
+		}
+
+		if m := bulletPattern.FindStringSubmatch(line); m != nil {
+			marker, text := "•", m[2]
+			if c := checkboxPattern.FindStringSubmatch(text); c != nil {
+				marker, text = "☐", c[2]
+				if c[1] != " " {
+					marker = "☑"
+				}
+			}
+			out = append(out, "  "+m[1]+marker+" "+f.renderInline(text))
+			continue
+		}
+
+		if m := orderedPattern.FindStringSubmatch(line); m != nil {
+			out = append(out, "  "+m[1]+m[2]+". "+f.renderInline(m[3]))
+			continue
+		}
+
+		out = append(out, f.renderInline(line))
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// renderInline styles code spans, links, bold, and italic text. Code spans
+// are set aside first so markup inside them is left alone.
+func (f *Formatter) renderInline(text string) string {
+	var spans []string
+	text = codeSpanPattern.ReplaceAllStringFunc(text, func(s string) string {
+		spans = append(spans, codeSpanPattern.FindStringSubmatch(s)[1])
+		return codeSpanSentinel + strconv.Itoa(len(spans)-1) + codeSpanSentinel
+	})
+
+	text = linkPattern.ReplaceAllStringFunc(text, func(s string) string {
+		m := linkPattern.FindStringSubmatch(s)
+		label, url := m[1], m[2]
+		if label == "" || label == url {
+			return f.colorize("blue", url)
+		}
+		return label + " (" + f.colorize("blue", url) + ")"
+	})
+	text = boldPattern.ReplaceAllStringFunc(text, func(s string) string {
+		m := boldPattern.FindStringSubmatch(s)
+		return f.colorize("bold", m[1]+m[2])
+	})
+	text = italicPattern.ReplaceAllStringFunc(text, func(s string) string {
+		m := italicPattern.FindStringSubmatch(s)
+		return f.colorize("italic", m[1]+m[2])
+	})
+
+	return codeSpanSeparator.ReplaceAllStringFunc(text, func(s string) string {
+		idx, _ := strconv.Atoi(codeSpanSeparator.FindStringSubmatch(s)[1])
+		return f.colorize("cyan", spans[idx])
+	})
+}
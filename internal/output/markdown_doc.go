@@ -0,0 +1,180 @@
+package output
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+const taskURLPrefix = "https://app.clickup.com/t/"
+
+var markdownCellEscapes = strings.NewReplacer("|", `\|`, "\n", "<br>")
+
+// markdownTextEscapes keeps task and checklist names from breaking the
+// links they are the text of, or from being read as emphasis or code.
+var markdownTextEscapes = strings.NewReplacer(
+	`\`, `\\`, "[", `\[`, "]", `\]`, "*", `\*`, "_", `\_`, "`", "\\`",
+)
+
+// formatMarkdown renders a slice of structs as a markdown table and a
+// single struct as a bullet list of its fields.
+func (f *Formatter) formatMarkdown(data any) (string, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Slice {
+		elemType := v.Type().Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		if elemType.Kind() != reflect.Struct {
+			return f.formatSlice(v)
+		}
+
+		fields := exportedFields(elemType)
+		header := make([]string, len(fields))
+		rule := make([]string, len(fields))
+		for col, idx := range fields {
+			header[col] = elemType.Field(idx).Name
+			rule[col] = "---"
+		}
+		lines := []string{markdownRow(header), markdownRow(rule)}
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i)
+			if item.Kind() == reflect.Ptr {
+				item = item.Elem()
+			}
+			row := make([]string, len(fields))
+			for col, idx := range fields {
+				cell, err := f.cellValue(item.Field(idx))
+				if err != nil {
+					return "", err
+				}
+				row[col] = markdownCellEscapes.Replace(cell)
+			}
+			lines = append(lines, markdownRow(row))
+		}
+		return strings.Join(lines, "\n"), nil
+	}
+
+	if v.Kind() != reflect.Struct {
+		return fmt.Sprintf("%v", v.Interface()), nil
+	}
+	t := v.Type()
+	var lines []string
+	for _, i := range exportedFields(t) {
+		cell, err := f.cellValue(v.Field(i))
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, fmt.Sprintf("- **%s:** %s", t.Field(i).Name, cell))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func markdownRow(cells []string) string {
+	return "| " + strings.Join(cells, " | ") + " |"
+}
+
+func (f *Formatter) formatTaskListMarkdown(tasks []api.Task, recursive bool) string {
+	if !recursive {
+		var lines []string
+		for _, task := range tasks {
+			lines = append(lines, markdownTaskItem(task, 0)...)
+		}
+		return strings.Join(lines, "\n")
+	}
+	return strings.Join(walkSubtasks(tasks, 0, markdownTaskItem), "\n")
+}
+
+// FormatTaskMarkdown renders a task as a standalone markdown document with
+// its fields, description, subtasks, checklists, and comments.
+func (f *Formatter) FormatTaskMarkdown(task api.Task, comments []api.Comment) string {
+	lines := []string{fmt.Sprintf("# [%s](%s)", markdownTextEscapes.Replace(task.Name), taskURL(task)), ""}
+
+	lines = append(lines, fmt.Sprintf("- **ID:** %s", task.ID))
+	if task.Status != nil {
+		lines = append(lines, fmt.Sprintf("- **Status:** %s", task.Status.Status))
+	}
+	if task.Priority != nil {
+		lines = append(lines, fmt.Sprintf("- **Priority:** %s", task.Priority.Priority))
+	}
+	if task.Assignee != nil {
+		lines = append(lines, fmt.Sprintf("- **Assignee:** %s", task.Assignee.Username))
+	}
+	if task.DueDate != "" {
+		lines = append(lines, fmt.Sprintf("- **Due:** %s", templateDate("2006-01-02", task.DueDate)))
+	}
+
+	description := task.MarkdownDescription
+	if description == "" {
+		description = task.Description
+	}
+	if strings.TrimSpace(description) != "" {
+		lines = append(lines, "", "## Description", "", strings.TrimSpace(description))
+	}
+
+	if len(task.Subtasks) > 0 {
+		lines = append(lines, "", "## Subtasks", "")
+		lines = append(lines, walkSubtasks(task.Subtasks, 0, markdownTaskItem)...)
+	}
+
+	if len(task.Checklists) > 0 {
+		lines = append(lines, "", "## Checklists")
+		for _, checklist := range task.Checklists {
+			lines = append(lines, "", "### "+markdownTextEscapes.Replace(checklist.Name), "")
+			for _, item := range checklist.Items {
+				lines = append(lines, checkbox(item.Resolved)+markdownTextEscapes.Replace(item.Name))
+			}
+		}
+	}
+
+	if len(comments) > 0 {
+		lines = append(lines, "", "## Comments")
+		for _, comment := range comments {
+			lines = append(lines, "", fmt.Sprintf("**%s** — %s", comment.User.Username, templateDate("2006-01-02 15:04", comment.DateCreated)), "")
+			for _, line := range strings.Split(strings.TrimRight(comment.TextContent, "\n"), "\n") {
+				lines = append(lines, strings.TrimRight("> "+line, " "))
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// markdownTaskItem renders a task as a checklist item linking back to
+// ClickUp, checked when the task is closed.
+func markdownTaskItem(task api.Task, indent int) []string {
+	line := strings.Repeat("  ", indent) + checkbox(task.DateClosed != "") +
+		fmt.Sprintf("[%s](%s)", markdownTextEscapes.Replace(task.Name), taskURL(task))
+
+	var details []string
+	if task.Status != nil && task.Status.Status != "" {
+		details = append(details, task.Status.Status)
+	}
+	if task.Assignee != nil {
+		details = append(details, "@"+task.Assignee.Username)
+	}
+	if len(details) > 0 {
+		line += " — " + strings.Join(details, ", ")
+	}
+	return []string{line}
+}
+
+func checkbox(checked bool) string {
+	if checked {
+		return "- [x] "
+	}
+	return "- [ ] "
+}
+
+func taskURL(task api.Task) string {
+	if task.URL != "" {
+		return task.URL
+	}
+	return taskURLPrefix + task.ID
+}
@@ -0,0 +1,119 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+func TestMarkdownFormatter_List(t *testing.T) {
+	tasks := []sampleTask{
+		{ID: "abc123", Title: "Pipe | in title", Status: "open"},
+	}
+	formatter, _ := NewFormatter("markdown")
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "| ID | Title | Assignee | Status | Priority |\n" +
+		"| --- | --- | --- | --- | --- |\n" +
+		`| abc123 | Pipe \| in title |  | open |  |`
+	if output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestFormatTaskList_Markdown(t *testing.T) {
+	tasks := []api.Task{
+		{
+			ID:       "parent",
+			Name:     "Parent Task",
+			Assignee: &api.User{Username: "john"},
+			Subtasks: []api.Task{
+				{ID: "child", Name: "Child Task", DateClosed: "1735689600000", URL: "https://app.clickup.com/t/custom"},
+			},
+		},
+	}
+	formatter, _ := NewFormatter("markdown")
+
+	output, err := formatter.FormatTaskList(tasks, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "- [ ] [Parent Task](https://app.clickup.com/t/parent) — @john\n" +
+		"  - [x] [Child Task](https://app.clickup.com/t/custom)"
+	if output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestFormatTaskList_MarkdownEscapesLinkText(t *testing.T) {
+	tasks := []api.Task{{ID: "task1", Name: "Fix [urgent] *login* in `auth_flow`"}}
+	formatter, _ := NewFormatter("markdown")
+
+	output, err := formatter.FormatTaskList(tasks, false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "- [ ] [Fix \\[urgent\\] \\*login\\* in \\`auth\\_flow\\`](https://app.clickup.com/t/task1)"
+	if output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestFormatTaskMarkdown(t *testing.T) {
+	task := api.Task{
+		ID:                  "task123",
+		Name:                "Release checklist",
+		MarkdownDescription: "Ship **it**",
+		Subtasks: []api.Task{
+			{ID: "sub1", Name: "Tag release"},
+		},
+		Checklists: []api.Checklist{
+			{
+				Name: "Definition of done",
+				Items: []api.ChecklistItem{
+					{Name: "Tests pass", Resolved: true},
+					{Name: "Docs updated"},
+				},
+			},
+		},
+	}
+	comments := []api.Comment{
+		{TextContent: "Ready\nfor review", User: api.User{Username: "jane"}},
+	}
+	formatter, _ := NewFormatter("markdown")
+
+	output := formatter.FormatTaskMarkdown(task, comments)
+
+	for _, want := range []string{
+		"# [Release checklist](https://app.clickup.com/t/task123)",
+		"- **ID:** task123",
+		"## Description\n\nShip **it**",
+		"## Subtasks\n\n- [ ] [Tag release](https://app.clickup.com/t/sub1)",
+		"### Definition of done\n\n- [x] Tests pass\n- [ ] Docs updated",
+		"## Comments\n\n**jane** — \n\n> Ready\n> for review",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestFormatTaskMarkdownEscapesChecklists(t *testing.T) {
+	task := api.Task{ID: "task1", Name: "Task", Checklists: []api.Checklist{
+		{Name: "*Done* when", Items: []api.ChecklistItem{{Name: "[ship] `v2`", Resolved: true}}},
+	}}
+	formatter, _ := NewFormatter("markdown")
+
+	output := formatter.FormatTaskMarkdown(task, nil)
+
+	if !strings.Contains(output, "### \\*Done\\* when\n\n- [x] \\[ship\\] \\`v2\\`") {
+		t.Errorf("expected checklist names escaped, got:\n%s", output)
+	}
+}
@@ -0,0 +1,91 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdown_Plain(t *testing.T) {
+	src := strings.Join([]string{
+		"# Release notes",
+		"Some **bold** and *italic* text with `code_span` and [docs](https://example.com).",
+		"- first",
+		"  - nested",
+		"- [x] done",
+		"- [ ] todo",
+		"1. step one",
+		"> quoted",
+		"```",
+		"go test ./...",
+		"```",
+	}, "\n")
+	formatter, _ := NewFormatter("text")
+
+	output := formatter.RenderMarkdown(src)
+
+	expected := strings.Join([]string{
+		"Release notes",
+		"=============",
+		"Some bold and italic text with code_span and docs (https://example.com).",
+		"  • first",
+		"    • nested",
+		"  ☑ done",
+		"  ☐ todo",
+		"  1. step one",
+		"│ quoted",
+		"    go test ./...",
+	}, "\n")
+	if output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestRenderMarkdown_Colored(t *testing.T) {
+	formatter, _ := NewFormatter("text")
+	formatter.SetColor(true)
+
+	output := formatter.RenderMarkdown("## Heading\nuse `**not bold**` here")
+
+	if !strings.HasPrefix(output, "\x1b[1mHeading\x1b[0m\n-------") {
+		t.Errorf("expected bold heading with underline, got %q", output)
+	}
+	if !strings.Contains(output, "\x1b[36m**not bold**\x1b[0m") {
+		t.Errorf("markup inside code spans should be left alone, got %q", output)
+	}
+}
+
+func TestFormatDetails_Text(t *testing.T) {
+	type header struct {
+		ID    string
+		Title string
+	}
+	formatter, _ := NewFormatter("text")
+
+	output, err := formatter.FormatDetails(nil, header{ID: "abc123", Title: "Fix bug"},
+		Section{Title: "Description", Body: "Line one\nLine two"},
+		Section{Title: "Comments (0)", Body: ""},
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "ID:     abc123\nTitle:  Fix bug\n\nDescription\n───────────\nLine one\nLine two"
+	if output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestFormatDetails_OtherFormatsRenderData(t *testing.T) {
+	formatter, _ := NewFormatter("json")
+
+	output, err := formatter.FormatDetails(sampleTask{ID: "abc123"}, struct{ ID string }{"ignored"},
+		Section{Title: "Description", Body: "ignored"},
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, `"ID": "abc123"`) || strings.Contains(output, "ignored") {
+		t.Errorf("expected data rendered as JSON, got %s", output)
+	}
+}
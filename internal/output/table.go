@@ -0,0 +1,266 @@
+package output
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+const (
+	columnGap      = "  "
+	minTitleWidth  = 10
+	truncateMarker = "…"
+)
+
+// Indented is implemented by rows that nest under a previous row, such as
+// subtasks under their parent task. Text and table output indent them.
+type Indented interface {
+	IndentLevel() int
+}
+
+// RowStyle implements Indented and Colored for row types built with
+// reflect.StructOf, which only gain methods through an embedded first
+// field. Tag the field `json:"-"` so that no format renders it. Colors maps
+// field names to colors.
+type RowStyle struct {
+	Depth  int
+	Colors map[string]string
+}
+
+func (s RowStyle) IndentLevel() int {
+	return s.Depth
+}
+
+func (s RowStyle) FieldColor(name string) string {
+	return s.Colors[name]
+}
+
+func indentPrefix(v reflect.Value) string {
+	if !v.CanInterface() {
+		return ""
+	}
+	if row, ok := v.Interface().(Indented); ok {
+		return strings.Repeat("  ", row.IndentLevel())
+	}
+	return ""
+}
+
+func (f *Formatter) formatTable(data any) (string, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() == reflect.Slice {
+		return f.formatTableRows(v)
+	}
+	return f.formatTableRecord(v)
+}
+
+// formatTableRows renders a slice of structs as aligned columns with a
+// header row. The title column is indented for nested rows and truncated
+// to fit the terminal width.
+func (f *Formatter) formatTableRows(v reflect.Value) (string, error) {
+	elemType := v.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return f.formatSlice(v)
+	}
+
+	fields := exportedFields(elemType)
+	header := make([]string, len(fields))
+	titleCol := -1
+	for col, idx := range fields {
+		name := elemType.Field(idx).Name
+		if name == "Title" || name == "Name" {
+			titleCol = col
+		}
+		header[col] = strings.ToUpper(name)
+	}
+
+	rows := [][]string{header}
+	for i := 0; i < v.Len(); i++ {
+		item := v.Index(i)
+		prefix := indentPrefix(item)
+		if item.Kind() == reflect.Ptr {
+			item = item.Elem()
+		}
+		row := make([]string, len(fields))
+		for col, idx := range fields {
+			cell, err := f.displayValue(item, idx)
+			if err != nil {
+				return "", err
+			}
+			row[col] = cell
+		}
+		if titleCol >= 0 {
+			row[titleCol] = prefix + row[titleCol]
+		} else if len(row) > 0 {
+			row[0] = prefix + row[0]
+		}
+		rows = append(rows, row)
+	}
+
+	widths := columnWidths(rows)
+	if titleCol >= 0 {
+		f.fitTitleColumn(widths, titleCol)
+	}
+	return renderRows(rows, widths, titleCol), nil
+}
+
+// formatTableRecord renders a single struct as aligned name/value pairs.
+func (f *Formatter) formatTableRecord(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Sprintf("%v", v.Interface()), nil
+	}
+
+	t := v.Type()
+	fields := exportedFields(t)
+	nameWidth := 0
+	for _, i := range fields {
+		if len(t.Field(i).Name) > nameWidth {
+			nameWidth = len(t.Field(i).Name)
+		}
+	}
+
+	var lines []string
+	for _, i := range fields {
+		field := t.Field(i)
+		name := padRight(field.Name, nameWidth) + columnGap
+		value := v.Field(i)
+		if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Struct {
+			if value.Len() == 0 {
+				lines = append(lines, strings.TrimRight(name, " "))
+				continue
+			}
+			for j := 0; j < value.Len(); j++ {
+				item, err := f.formatStruct(value.Index(j))
+				if err != nil {
+					return "", err
+				}
+				lines = append(lines, name+item)
+				name = strings.Repeat(" ", nameWidth) + columnGap
+			}
+			continue
+		}
+		cell, err := f.displayValue(v, i)
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, strings.TrimRight(name+cell, " "))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// fitTitleColumn shrinks the title column so a row fits the terminal width.
+func (f *Formatter) fitTitleColumn(widths []int, titleCol int) {
+	if f.width <= 0 {
+		return
+	}
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	total += len(columnGap) * (len(widths) - 1)
+	if total <= f.width {
+		return
+	}
+	fitted := widths[titleCol] - (total - f.width)
+	if fitted < minTitleWidth {
+		fitted = minTitleWidth
+	}
+	if fitted < widths[titleCol] {
+		widths[titleCol] = fitted
+	}
+}
+
+func columnWidths(rows [][]string) []int {
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for col, cell := range row {
+			if n := visibleWidth(cell); n > widths[col] {
+				widths[col] = n
+			}
+		}
+	}
+	return widths
+}
+
+func renderRows(rows [][]string, widths []int, titleCol int) string {
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for col, cell := range row {
+			if col == titleCol {
+				cell = truncate(cell, widths[col])
+			}
+			cells[col] = padRight(cell, widths[col])
+		}
+		lines = append(lines, strings.TrimRight(strings.Join(cells, columnGap), " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// visibleWidth counts the runes of s that take up space on the terminal,
+// ignoring ANSI color sequences.
+func visibleWidth(s string) int {
+	return utf8.RuneCountInString(ansiPattern.ReplaceAllString(s, ""))
+}
+
+func truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:width-1]) + truncateMarker
+}
+
+func padRight(s string, width int) string {
+	n := visibleWidth(s)
+	if n >= width {
+		return s
+	}
+	return s + strings.Repeat(" ", width-n)
+}
+
+type taskRow struct {
+	ID       string
+	Title    string
+	Assignee string
+	Status   string
+	Priority string
+
+	level int
+}
+
+func (r taskRow) IndentLevel() int {
+	return r.level
+}
+
+func taskRows(tasks []api.Task, indent int, recursive bool) []taskRow {
+	rows := []taskRow{}
+	for _, task := range tasks {
+		row := taskRow{ID: task.ID, Title: task.Name, level: indent}
+		if task.Assignee != nil {
+			row.Assignee = task.Assignee.Username
+		}
+		if task.Status != nil {
+			row.Status = task.Status.Status
+		}
+		if task.Priority != nil {
+			row.Priority = task.Priority.Priority
+		}
+		rows = append(rows, row)
+		if recursive {
+			rows = append(rows, taskRows(task.Subtasks, indent+1, true)...)
+		}
+	}
+	return rows
+}
@@ -0,0 +1,116 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+func TestTableFormatter_AlignsColumns(t *testing.T) {
+	tasks := []sampleTask{
+		{ID: "abc123", Title: "Short", Status: "open"},
+		{ID: "d4", Title: "A much longer title", Status: "in progress"},
+	}
+	formatter, _ := NewFormatter("table")
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(output, "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d lines", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "ID") || !strings.Contains(lines[0], "TITLE") {
+		t.Errorf("expected header row, got %q", lines[0])
+	}
+	titleCol := strings.Index(lines[0], "TITLE")
+	for _, line := range lines[1:] {
+		if line[titleCol-1] != ' ' || line[titleCol] == ' ' {
+			t.Errorf("title column not aligned in %q", line)
+		}
+	}
+}
+
+func TestTableFormatter_TruncatesTitleToWidth(t *testing.T) {
+	tasks := []sampleTask{
+		{ID: "abc123", Title: strings.Repeat("long ", 20), Status: "open"},
+	}
+	formatter, _ := NewFormatter("table")
+	formatter.SetWidth(60)
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if n := len([]rune(line)); n > 60 {
+			t.Errorf("line exceeds width (%d): %q", n, line)
+		}
+	}
+	if !strings.Contains(output, "…") {
+		t.Error("truncated title should end with an ellipsis")
+	}
+}
+
+func TestTableFormatter_NoTruncationWithoutWidth(t *testing.T) {
+	title := strings.Repeat("long ", 20)
+	tasks := []sampleTask{{ID: "abc123", Title: title}}
+	formatter, _ := NewFormatter("table")
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, strings.TrimSpace(title)) {
+		t.Error("title should not be truncated when width is unknown")
+	}
+}
+
+func TestTableFormatter_SingleItem(t *testing.T) {
+	task := sampleTask{ID: "abc123", Title: "Fix login bug"}
+	formatter, _ := NewFormatter("table")
+
+	output, err := formatter.Format(task)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "ID        abc123") {
+		t.Errorf("expected aligned name/value pairs, got:\n%s", output)
+	}
+}
+
+func TestFormatTaskList_Table(t *testing.T) {
+	tasks := []api.Task{
+		{
+			ID:   "parent",
+			Name: "Parent Task",
+			Subtasks: []api.Task{
+				{ID: "child", Name: "Child Task"},
+			},
+		},
+	}
+	formatter, _ := NewFormatter("table")
+
+	output, err := formatter.FormatTaskList(tasks, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(output, "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d lines", len(lines))
+	}
+	titleCol := strings.Index(lines[0], "TITLE")
+	if !strings.HasPrefix(lines[1][titleCol:], "Parent Task") {
+		t.Errorf("parent title should start at title column: %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[2][titleCol:], "  Child Task") {
+		t.Errorf("child title should be indented under parent: %q", lines[2])
+	}
+}
@@ -0,0 +1,171 @@
+package output
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var templateEscapes = strings.NewReplacer(`\t`, "\t", `\n`, "\n")
+
+var ansiColors = map[string]string{
+	"bold":    "1",
+	"italic":  "3",
+	"red":     "31",
+	"green":   "32",
+	"yellow":  "33",
+	"blue":    "34",
+	"magenta": "35",
+	"cyan":    "36",
+	"white":   "37",
+	"gray":    "90",
+}
+
+// ExpandEscapes expands the escapes \t and \n in the text of a template,
+// so that templates can be written inline on the command line. Actions
+// are left alone, so escapes in their string literals keep their Go
+// meaning.
+func ExpandEscapes(text string) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(text, "{{")
+		if start < 0 {
+			b.WriteString(templateEscapes.Replace(text))
+			return b.String()
+		}
+		b.WriteString(templateEscapes.Replace(text[:start]))
+		text = text[start:]
+
+		end := strings.Index(text, "}}")
+		if end < 0 {
+			b.WriteString(text)
+			return b.String()
+		}
+		b.WriteString(text[:end+2])
+		text = text[end+2:]
+	}
+}
+
+// SetTemplate parses the Go template used by the template format.
+func (f *Formatter) SetTemplate(text string) error {
+	tmpl, err := template.New("output").Funcs(f.templateFuncs()).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	f.template = tmpl
+	return nil
+}
+
+// formatTemplate executes the template once per element of a slice, one
+// element per line, or once for any other value.
+func (f *Formatter) formatTemplate(data any) (string, error) {
+	if f.template == nil {
+		return "", fmt.Errorf("template output requires --template or --template-file")
+	}
+
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		return f.executeTemplate(data)
+	}
+
+	lines := make([]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		line, err := f.executeTemplate(v.Index(i).Interface())
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func (f *Formatter) executeTemplate(data any) (string, error) {
+	var sb strings.Builder
+	if err := f.template.Execute(&sb, data); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func (f *Formatter) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"date":     templateDate,
+		"color":    templateColor,
+		"pad":      templatePad,
+		"padLeft":  templatePadLeft,
+		"truncate": templateTruncate,
+		"join":     templateJoin,
+		"upper":    func(v any) string { return strings.ToUpper(fmt.Sprint(v)) },
+		"lower":    func(v any) string { return strings.ToLower(fmt.Sprint(v)) },
+	}
+}
+
+// templateDate formats a ClickUp timestamp (milliseconds since the epoch)
+// with a Go time layout. Values that are not timestamps pass through.
+func templateDate(layout string, v any) string {
+	s := fmt.Sprint(v)
+	if s == "" {
+		return ""
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return s
+	}
+	return time.UnixMilli(ms).Format(layout)
+}
+
+// templateColor wraps text in an ANSI color, given by name or as a hex
+// "#rrggbb" value like the colors ClickUp returns for statuses.
+func templateColor(color string, v any) string {
+	s := fmt.Sprint(v)
+	if code, ok := ansiColors[color]; ok {
+		return "\x1b[" + code + "m" + s + "\x1b[0m"
+	}
+	if r, g, b, ok := parseHexColor(color); ok {
+		return fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s\x1b[0m", r, g, b, s)
+	}
+	return s
+}
+
+func templatePad(width int, v any) string {
+	return padRight(fmt.Sprint(v), width)
+}
+
+func templatePadLeft(width int, v any) string {
+	s := fmt.Sprint(v)
+	return strings.Repeat(" ", max(0, width-len([]rune(s)))) + s
+}
+
+func templateTruncate(width int, v any) string {
+	if width < 1 {
+		return ""
+	}
+	return truncate(fmt.Sprint(v), width)
+}
+
+func templateJoin(sep string, v any) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return fmt.Sprint(v)
+	}
+	parts := make([]string, rv.Len())
+	for i := range parts {
+		parts[i] = fmt.Sprint(rv.Index(i).Interface())
+	}
+	return strings.Join(parts, sep)
+}
+
+func parseHexColor(hex string) (r, g, b uint8, ok bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return uint8(n >> 16), uint8(n >> 8), uint8(n), true
+}
@@ -0,0 +1,113 @@
+package output
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestTemplateFormatter_List(t *testing.T) {
+	tasks := []sampleTask{
+		{ID: "abc123", Title: "Task 1"},
+		{ID: "def456", Title: "Task 2"},
+	}
+	formatter, _ := NewFormatter("template")
+	if err := formatter.SetTemplate(ExpandEscapes(`{{.ID}}\t{{.Title}}`)); err != nil {
+		t.Fatalf("unexpected template error: %v", err)
+	}
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "abc123\tTask 1\ndef456\tTask 2" {
+		t.Errorf("unexpected output: %q", output)
+	}
+}
+
+func TestExpandEscapesSkipsActions(t *testing.T) {
+	expanded := ExpandEscapes(`{{printf "%s\n" .ID}}\t{{.Title}}\n`)
+
+	if expanded != "{{printf \"%s\\n\" .ID}}\t{{.Title}}\n" {
+		t.Errorf("expected escapes expanded only outside actions, got %q", expanded)
+	}
+	formatter, _ := NewFormatter("template")
+	if err := formatter.SetTemplate(expanded); err != nil {
+		t.Errorf("expected the expanded template to parse, got %v", err)
+	}
+}
+
+func TestTemplateFormatter_SingleItem(t *testing.T) {
+	formatter, _ := NewFormatter("template")
+	formatter.SetTemplate(`{{.Title | upper}}`)
+
+	output, err := formatter.Format(sampleTask{ID: "abc123", Title: "Test"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "TEST" {
+		t.Errorf("expected 'TEST', got %q", output)
+	}
+}
+
+func TestTemplateFormatter_RequiresTemplate(t *testing.T) {
+	formatter, _ := NewFormatter("template")
+
+	_, err := formatter.Format(sampleTask{ID: "abc123"})
+
+	if err == nil {
+		t.Fatal("expected error without a template, got nil")
+	}
+}
+
+func TestTemplateFormatter_InvalidTemplate(t *testing.T) {
+	formatter, _ := NewFormatter("template")
+
+	err := formatter.SetTemplate(`{{.ID`)
+
+	if err == nil {
+		t.Fatal("expected parse error, got nil")
+	}
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	due := time.Date(2025, 12, 31, 12, 0, 0, 0, time.Local).UnixMilli()
+	tests := []struct {
+		name     string
+		template string
+		data     any
+		expected string
+	}{
+		{"pad", `[{{pad 6 .}}]`, "ab", "[ab    ]"},
+		{"padLeft", `[{{padLeft 6 .}}]`, "ab", "[    ab]"},
+		{"truncate", `{{truncate 5 .}}`, "abcdefgh", "abcd…"},
+		{"truncate short", `{{truncate 5 .}}`, "abc", "abc"},
+		{"join", `{{join ", " .Tags}}`, struct{ Tags []string }{[]string{"a", "b"}}, "a, b"},
+		{"date", `{{date "2006-01-02" .}}`, strconv.FormatInt(due, 10), "2025-12-31"},
+		{"date empty", `{{date "2006-01-02" .}}`, "", ""},
+		{"date passthrough", `{{date "2006-01-02" .}}`, "tomorrow", "tomorrow"},
+		{"color name", `{{color "red" .}}`, "late", "\x1b[31mlate\x1b[0m"},
+		{"color hex", `{{color "#ff0000" .}}`, "late", "\x1b[38;2;255;0;0mlate\x1b[0m"},
+		{"color unknown", `{{color "plaid" .}}`, "late", "late"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formatter, _ := NewFormatter("template")
+			if err := formatter.SetTemplate(tt.template); err != nil {
+				t.Fatalf("unexpected template error: %v", err)
+			}
+
+			output, err := formatter.Format(tt.data)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
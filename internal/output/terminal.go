@@ -0,0 +1,26 @@
+package output
+
+import (
+	"os"
+	"strconv"
+)
+
+// TerminalWidth returns the width of the terminal attached to file. The
+// COLUMNS environment variable takes precedence; 0 means the width is
+// unknown, for example when output is piped.
+func TerminalWidth(file *os.File) int {
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	return terminalWidth(file)
+}
+
+// IsTerminal reports whether file is a character device, such as an
+// interactive terminal rather than a pipe or regular file.
+func IsTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package output
+
+import "os"
+
+func terminalWidth(file *os.File) int {
+	return 0
+}
@@ -0,0 +1,62 @@
+package output
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// formatYAML renders data as YAML. Data is encoded as JSON first so keys
+// and field order match the json output exactly.
+func (f *Formatter) formatYAML(data any) (string, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return "", err
+	}
+	blockStyle(&node)
+
+	out, err := yaml.Marshal(&node)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+// blockStyle clears the flow and quoting styles inherited from the JSON
+// source, leaving the encoder to choose idiomatic YAML.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// formatNDJSON renders each element of a slice as one compact JSON object
+// per line. Anything else is rendered as a single line.
+func (f *Formatter) formatNDJSON(data any) (string, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+
+	lines := make([]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		b, err := json.Marshal(v.Index(i).Interface())
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, string(b))
+	}
+	return strings.Join(lines, "\n"), nil
+}
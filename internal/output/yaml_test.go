@@ -0,0 +1,88 @@
+package output
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Fire-Dragon-DoL/clickup-cli/internal/api"
+)
+
+func TestYAMLFormatter_SingleItem(t *testing.T) {
+	task := sampleTask{ID: "abc123", Title: "Fix login bug"}
+	formatter, _ := NewFormatter("yaml")
+
+	output, err := formatter.Format(task)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "ID: abc123\nTitle: Fix login bug\nAssignee: \"\"\nStatus: \"\"\nPriority: \"\""
+	if output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestYAMLFormatter_UsesJSONKeys(t *testing.T) {
+	tasks := []api.Task{{ID: "123", Name: "Task 1"}}
+	formatter, _ := NewFormatter("yaml")
+
+	output, err := formatter.FormatTaskList(tasks, false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(output, "- id: \"123\"\n  custom_id: \"\"\n  name: Task 1") {
+		t.Errorf("expected block sequence with JSON keys in order, got:\n%s", output)
+	}
+}
+
+func TestNDJSONFormatter_List(t *testing.T) {
+	tasks := []sampleTask{
+		{ID: "abc123", Title: "Task 1"},
+		{ID: "def456", Title: "Task 2"},
+	}
+	formatter, _ := NewFormatter("ndjson")
+
+	output, err := formatter.Format(tasks)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(output, "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected one line per item, got %d", len(lines))
+	}
+	var decoded sampleTask
+	if err := json.Unmarshal([]byte(lines[1]), &decoded); err != nil {
+		t.Fatalf("line is not valid JSON: %v", err)
+	}
+	if decoded.ID != "def456" {
+		t.Errorf("expected second line to hold 'def456', got %q", decoded.ID)
+	}
+}
+
+func TestNDJSONFormatter_SingleItem(t *testing.T) {
+	formatter, _ := NewFormatter("ndjson")
+
+	output, err := formatter.Format(sampleTask{ID: "abc123"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output, "\n") || !strings.HasPrefix(output, "{") {
+		t.Errorf("expected a single JSON object line, got: %s", output)
+	}
+}
+
+func TestFormatter_Streaming(t *testing.T) {
+	ndjson, _ := NewFormatter("ndjson")
+	json, _ := NewFormatter("json")
+
+	if !ndjson.Streaming() {
+		t.Error("ndjson output should stream")
+	}
+	if json.Streaming() {
+		t.Error("json output should not stream")
+	}
+}